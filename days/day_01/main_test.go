@@ -1,31 +0,0 @@
-package day_01_test
-
-import (
-	"github.com/wlchs/advent_of_code_go_template/days/day_01"
-	"github.com/wlchs/advent_of_code_go_template/internal"
-	"testing"
-)
-
-func TestPartOne(t *testing.T) {
-	t.Parallel()
-
-	input := internal.LoadInputLines("input_1_test.txt")
-	expectedResult := internal.LoadFirstInputLine("solution_1.txt")
-	result := day_01.Part1(input)
-
-	if result != expectedResult {
-		t.Errorf("expected result was %s, but got %s instead", expectedResult, result)
-	}
-}
-
-func TestPartTwo(t *testing.T) {
-	t.Parallel()
-
-	input := internal.LoadInputLines("input_2_test.txt")
-	expectedResult := internal.LoadFirstInputLine("solution_2.txt")
-	result := day_01.Part2(input)
-
-	if result != expectedResult {
-		t.Errorf("expected result was %s, but got %s instead", expectedResult, result)
-	}
-}
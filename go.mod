@@ -1,3 +1,8 @@
 module github.com/wlchs/advent_of_code_go_template
 
 go 1.21.4
+
+require (
+	github.com/BurntSushi/toml v1.3.2
+	github.com/klauspost/compress v1.17.4
+)
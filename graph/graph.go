@@ -0,0 +1,181 @@
+// Package graph contains shortest path searches over implicit graphs, where the states are generated on the fly by a
+// neighbour function instead of being stored upfront.
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/wlchs/advent_of_code_go_template/types"
+	"slices"
+)
+
+// ErrNoPath is returned if none of the goal states can be reached from the start states
+var ErrNoPath = errors.New("no path found")
+
+// Edge is a transition to a neighbouring state with its cost.
+type Edge[S comparable] struct {
+	To   S
+	Cost int
+}
+
+// Path is a shortest path from one of the start states to a goal state.
+type Path[S comparable] struct {
+	// States are the states of the path, from the start state to the goal state.
+	States []S
+	// Cost is the sum of the costs of the edges along the path.
+	Cost int
+}
+
+// Dijkstra finds the cheapest path from any of the start states to a state satisfying the goal.
+// The costs of the edges must not be negative. It stops early if the context is done.
+func Dijkstra[S comparable](ctx context.Context, starts []S, neighbors func(S) []Edge[S], goal func(S) bool) (Path[S], error) {
+	return AStar(ctx, starts, neighbors, goal, func(S) int { return 0 })
+}
+
+// AStar finds the cheapest path from any of the start states to a state satisfying the goal.
+// The heuristic estimates the remaining cost to the goal; it must never overestimate it for the path to be the
+// cheapest. It doesn't have to be consistent: a state already expanded is expanded again once a cheaper path reaches
+// it. The costs of the edges must not be negative. It stops early if the context is done.
+func AStar[S comparable](ctx context.Context, starts []S, neighbors func(S) []Edge[S], goal func(S) bool, heuristic func(S) int) (Path[S], error) {
+	// the queue keeps the cost each state was queued with, so that outdated entries can be recognised
+	type queued struct {
+		state S
+		cost  int
+	}
+	costs := map[S]int{}
+	parents := map[S]S{}
+	expanded := map[S]int{}
+	queue := types.NewPriorityQueue[queued]()
+	for _, s := range starts {
+		costs[s] = 0
+		queue.Push(queued{state: s}, heuristic(s))
+	}
+	for queue.Len() > 0 {
+		if err := ctx.Err(); err != nil {
+			return Path[S]{}, err
+		}
+		q, _ := queue.Pop()
+		if q.cost > costs[q.state] {
+			continue
+		}
+		if cost, ok := expanded[q.state]; ok && cost <= q.cost {
+			continue
+		}
+		expanded[q.state] = q.cost
+		if goal(q.state) {
+			return path(parents, q.state, q.cost), nil
+		}
+		for _, e := range neighbors(q.state) {
+			if e.Cost < 0 {
+				panic(fmt.Sprintf("negative edge cost %d", e.Cost))
+			}
+			cost := q.cost + e.Cost
+			if old, ok := costs[e.To]; ok && old <= cost {
+				continue
+			}
+			costs[e.To] = cost
+			parents[e.To] = q.state
+			queue.Push(queued{state: e.To, cost: cost}, cost+heuristic(e.To))
+		}
+	}
+	return Path[S]{}, ErrNoPath
+}
+
+// BFS finds the path with the fewest steps from any of the start states to a state satisfying the goal.
+// The cost of the path is its number of steps. It stops early if the context is done.
+func BFS[S comparable](ctx context.Context, starts []S, neighbors func(S) []S, goal func(S) bool) (Path[S], error) {
+	steps := map[S]int{}
+	parents := map[S]S{}
+	var queue []S
+	for _, s := range starts {
+		if _, ok := steps[s]; !ok {
+			steps[s] = 0
+			queue = append(queue, s)
+		}
+	}
+	for len(queue) > 0 {
+		if err := ctx.Err(); err != nil {
+			return Path[S]{}, err
+		}
+		current := queue[0]
+		queue = queue[1:]
+		if goal(current) {
+			return path(parents, current, steps[current]), nil
+		}
+		for _, next := range neighbors(current) {
+			if _, ok := steps[next]; ok {
+				continue
+			}
+			steps[next] = steps[current] + 1
+			parents[next] = current
+			queue = append(queue, next)
+		}
+	}
+	return Path[S]{}, ErrNoPath
+}
+
+// ZeroOneBFS finds the cheapest path from any of the start states to a state satisfying the goal, where every edge
+// costs either 0 or 1. It panics on any other cost. It stops early if the context is done.
+func ZeroOneBFS[S comparable](ctx context.Context, starts []S, neighbors func(S) []Edge[S], goal func(S) bool) (Path[S], error) {
+	costs := map[S]int{}
+	parents := map[S]S{}
+	done := map[S]bool{}
+	// the deque consists of the reversed front stack followed by the back queue
+	var front, back []S
+	for _, s := range starts {
+		costs[s] = 0
+		back = append(back, s)
+	}
+	for len(front)+len(back) > 0 {
+		if err := ctx.Err(); err != nil {
+			return Path[S]{}, err
+		}
+		var current S
+		if len(front) > 0 {
+			current = front[len(front)-1]
+			front = front[:len(front)-1]
+		} else {
+			current = back[0]
+			back = back[1:]
+		}
+		if done[current] {
+			continue
+		}
+		done[current] = true
+		if goal(current) {
+			return path(parents, current, costs[current]), nil
+		}
+		for _, e := range neighbors(current) {
+			if e.Cost != 0 && e.Cost != 1 {
+				panic(fmt.Sprintf("edge cost %d is neither 0 nor 1", e.Cost))
+			}
+			cost := costs[current] + e.Cost
+			if old, ok := costs[e.To]; ok && old <= cost {
+				continue
+			}
+			costs[e.To] = cost
+			parents[e.To] = current
+			if e.Cost == 0 {
+				front = append(front, e.To)
+			} else {
+				back = append(back, e.To)
+			}
+		}
+	}
+	return Path[S]{}, ErrNoPath
+}
+
+// path reconstructs the path leading to the given state by following the parents back to a start state
+func path[S comparable](parents map[S]S, end S, cost int) Path[S] {
+	states := []S{end}
+	for {
+		parent, ok := parents[states[len(states)-1]]
+		if !ok {
+			break
+		}
+		states = append(states, parent)
+	}
+	slices.Reverse(states)
+	return Path[S]{States: states, Cost: cost}
+}
@@ -0,0 +1,150 @@
+package graph_test
+
+import (
+	"context"
+	"errors"
+	"github.com/wlchs/advent_of_code_go_template/graph"
+	"github.com/wlchs/advent_of_code_go_template/types"
+	"reflect"
+	"testing"
+)
+
+// maze is a grid of walls (#), free cells (.) and cheap cells (,) costing nothing to enter
+var maze = types.ParseGrid([]string{
+	"..#....",
+	".,#.##.",
+	".,,,#..",
+	"##.#..#",
+	"...,...",
+})
+
+var start = types.Vec2{}
+var end = types.Vec2{X: 6, Y: 4}
+
+// edges gives back the moves into the free neighbours, costing 0 for cheap cells and 1 otherwise
+func edges(v types.Vec2) []graph.Edge[types.Vec2] {
+	var res []graph.Edge[types.Vec2]
+	for _, n := range maze.Neighbors4(v) {
+		switch maze.At(n) {
+		case '.':
+			res = append(res, graph.Edge[types.Vec2]{To: n, Cost: 1})
+		case ',':
+			res = append(res, graph.Edge[types.Vec2]{To: n})
+		}
+	}
+	return res
+}
+
+// steps gives back the free neighbours regardless of their cost
+func steps(v types.Vec2) []types.Vec2 {
+	var res []types.Vec2
+	for _, e := range edges(v) {
+		res = append(res, e.To)
+	}
+	return res
+}
+
+func isEnd(v types.Vec2) bool {
+	return v == end
+}
+
+// checkPath checks that the path is a valid walk from the start to the end with the expected cost
+func checkPath(t *testing.T, name string, p graph.Path[types.Vec2], err error, cost int) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("%s: %v", name, err)
+	}
+	if p.Cost != cost {
+		t.Errorf("%s: expected a cost of %d, but got %d instead", name, cost, p.Cost)
+	}
+	if p.States[0] != start || p.States[len(p.States)-1] != end {
+		t.Errorf("%s: expected a path from %v to %v, but got %v instead", name, start, end, p.States)
+	}
+	for i := 1; i < len(p.States); i++ {
+		if d := p.States[i].ManhattanDistance(&p.States[i-1]); d != 1 {
+			t.Errorf("%s: invalid step from %v to %v", name, p.States[i-1], p.States[i])
+		}
+	}
+}
+
+func TestShortestPaths(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	starts := []types.Vec2{start}
+
+	p, err := graph.BFS(ctx, starts, steps, isEnd)
+	checkPath(t, "BFS", p, err, 10)
+	if len(p.States) != 11 {
+		t.Errorf("BFS: expected 11 states, but got %v instead", p.States)
+	}
+
+	p, err = graph.Dijkstra(ctx, starts, edges, isEnd)
+	checkPath(t, "Dijkstra", p, err, 6)
+
+	p, err = graph.ZeroOneBFS(ctx, starts, edges, isEnd)
+	checkPath(t, "ZeroOneBFS", p, err, 6)
+}
+
+func TestAStarHeuristic(t *testing.T) {
+	t.Parallel()
+
+	// without cheap cells the Manhattan distance never overestimates the remaining cost
+	neighbours := func(v types.Vec2) []graph.Edge[types.Vec2] {
+		var res []graph.Edge[types.Vec2]
+		for _, n := range maze.Neighbors4(v) {
+			if maze.At(n) != '#' {
+				res = append(res, graph.Edge[types.Vec2]{To: n, Cost: 1})
+			}
+		}
+		return res
+	}
+	p, err := graph.AStar(context.Background(), []types.Vec2{start}, neighbours, isEnd, func(v types.Vec2) int {
+		return v.ManhattanDistance(&end)
+	})
+	checkPath(t, "AStar", p, err, 10)
+}
+
+func TestAStarInconsistentHeuristic(t *testing.T) {
+	t.Parallel()
+
+	edges := map[string][]graph.Edge[string]{
+		"S": {{To: "A", Cost: 1}, {To: "B", Cost: 1}},
+		"A": {{To: "C", Cost: 1}},
+		"B": {{To: "C", Cost: 2}},
+		"C": {{To: "G", Cost: 3}},
+	}
+	// the estimate of A never exceeds its real distance of 4, but it drops by more than the cost of the edge to C,
+	// so C is first expanded through B with a cost of 3 and has to be expanded again through A with a cost of 2
+	h := map[string]int{"A": 4}
+	p, err := graph.AStar(context.Background(), []string{"S"}, func(s string) []graph.Edge[string] {
+		return edges[s]
+	}, func(s string) bool {
+		return s == "G"
+	}, func(s string) int {
+		return h[s]
+	})
+	if err != nil || p.Cost != 5 || !reflect.DeepEqual(p.States, []string{"S", "A", "C", "G"}) {
+		t.Errorf("expected the path S, A, C, G with a cost of 5, but got %+v, %v instead", p, err)
+	}
+}
+
+func TestNoPath(t *testing.T) {
+	t.Parallel()
+
+	walled := func(types.Vec2) bool { return false }
+	if _, err := graph.Dijkstra(context.Background(), []types.Vec2{start}, edges, walled); !errors.Is(err, graph.ErrNoPath) {
+		t.Errorf("expected ErrNoPath, but got %v instead", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := graph.BFS(ctx, []types.Vec2{start}, steps, isEnd); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected the search to stop, but got %v instead", err)
+	}
+
+	p, err := graph.ZeroOneBFS(context.Background(), []types.Vec2{end}, edges, isEnd)
+	if err != nil || !reflect.DeepEqual(p.States, []types.Vec2{end}) || p.Cost != 0 {
+		t.Errorf("expected an empty path from the goal, but got %+v, %v instead", p, err)
+	}
+}
@@ -0,0 +1,139 @@
+package internal
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// ErrAnswerMismatch is returned when a solver's answer differs from the recorded one.
+var ErrAnswerMismatch = errors.New("answer changed")
+
+// answersFile is the name of the answers store inside the inputs directory
+const answersFile = "answers.json"
+
+// verification statuses of the results
+const (
+	Verified   = "verified"
+	Unrecorded = "unrecorded"
+	Recorded   = "recorded"
+)
+
+// Answer is a recorded answer of a single part of a daily challenge for a specific input
+type Answer struct {
+	Year        int    `json:"year"`
+	Day         int    `json:"day"`
+	Part        int    `json:"part"`
+	InputSHA256 string `json:"input_sha256"`
+	Answer      string `json:"answer"`
+}
+
+// answerKey identifies the answer of a part for a specific input
+type answerKey struct {
+	year int
+	day  int
+	part int
+	hash string
+}
+
+// AnswerStore keeps the known answers of the actual inputs in a local JSON file
+type AnswerStore struct {
+	path    string
+	answers map[answerKey]string
+}
+
+// AnswersPath gives back the default location of the answers store inside the inputs directory.
+func AnswersPath(inputDir string) string {
+	return filepath.Join(inputDir, answersFile)
+}
+
+// LoadAnswers loads the answers store from the given path.
+// A missing file results in an empty store, which is created on Save.
+func LoadAnswers(path string) (*AnswerStore, error) {
+	s := &AnswerStore{path: path, answers: map[answerKey]string{}}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var answers []Answer
+	if err := json.Unmarshal(data, &answers); err != nil {
+		return nil, fmt.Errorf("failed to parse answers %s: %w", path, err)
+	}
+	for _, a := range answers {
+		s.answers[answerKey{year: a.Year, day: a.Day, part: a.Part, hash: a.InputSHA256}] = a.Answer
+	}
+	return s, nil
+}
+
+// Lookup finds the recorded answer of the part for the input with the given checksum.
+func (s *AnswerStore) Lookup(year int, day int, part int, hash string) (string, bool) {
+	answer, ok := s.answers[answerKey{year: year, day: day, part: part, hash: hash}]
+	return answer, ok
+}
+
+// Record stores the answer of the part for the input with the given checksum.
+func (s *AnswerStore) Record(year int, day int, part int, hash string, answer string) {
+	s.answers[answerKey{year: year, day: day, part: part, hash: hash}] = answer
+}
+
+// Save writes the answers to the store's file ordered by year, day and part.
+func (s *AnswerStore) Save() error {
+	answers := make([]Answer, 0, len(s.answers))
+	for k, v := range s.answers {
+		answers = append(answers, Answer{Year: k.year, Day: k.day, Part: k.part, InputSHA256: k.hash, Answer: v})
+	}
+	sort.Slice(answers, func(i, j int) bool {
+		a, b := answers[i], answers[j]
+		if a.Year != b.Year {
+			return a.Year < b.Year
+		}
+		if a.Day != b.Day {
+			return a.Day < b.Day
+		}
+		if a.Part != b.Part {
+			return a.Part < b.Part
+		}
+		return a.InputSHA256 < b.InputSHA256
+	})
+
+	data, err := json.MarshalIndent(answers, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(s.path, append(data, '\n'), 0o644)
+}
+
+// VerifyResults compares the answers of the successful results with the recorded ones.
+// A changed answer turns the result into a failure wrapping ErrAnswerMismatch.
+// If record is set, unknown and changed answers are stored instead.
+func VerifyResults(s *AnswerStore, results []Result, record bool) {
+	for i, r := range results {
+		if r.Err != nil || r.NotApplicable {
+			continue
+		}
+
+		expected, ok := s.Lookup(r.Year, r.Day, r.Part, r.InputSHA256)
+		switch {
+		case ok && expected == r.Answer:
+			results[i].Verification = Verified
+		case record:
+			s.Record(r.Year, r.Day, r.Part, r.InputSHA256, r.Answer)
+			results[i].Verification = Recorded
+		case ok:
+			results[i].Err = fmt.Errorf("%w, expected %s", ErrAnswerMismatch, expected)
+		default:
+			results[i].Verification = Unrecorded
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package internal_test
+
+import (
+	"errors"
+	"github.com/wlchs/advent_of_code_go_template/internal"
+	"path/filepath"
+	"testing"
+)
+
+func TestVerifyResults(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "inputs", "answers.json")
+	store, err := internal.LoadAnswers(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results := []internal.Result{
+		{Year: 2023, Day: 1, Part: 1, Answer: "142", InputSHA256: "abc"},
+		{Year: 2023, Day: 1, Part: 2, Answer: "281", InputSHA256: "abc"},
+	}
+	internal.VerifyResults(store, results, true)
+	if results[0].Verification != internal.Recorded || results[1].Verification != internal.Recorded {
+		t.Errorf("expected recorded answers, but got %+v instead", results)
+	}
+	if err := store.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	store, err = internal.LoadAnswers(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	results = []internal.Result{
+		{Year: 2023, Day: 1, Part: 1, Answer: "142", InputSHA256: "abc"},
+		{Year: 2023, Day: 1, Part: 2, Answer: "280", InputSHA256: "abc"},
+		{Year: 2023, Day: 1, Part: 2, Answer: "280", InputSHA256: "def"},
+	}
+	internal.VerifyResults(store, results, false)
+	if results[0].Verification != internal.Verified {
+		t.Errorf("expected verified answer, but got %+v instead", results[0])
+	}
+	if !errors.Is(results[1].Err, internal.ErrAnswerMismatch) {
+		t.Errorf("expected ErrAnswerMismatch, but got %v instead", results[1].Err)
+	}
+	if results[2].Verification != internal.Unrecorded {
+		t.Errorf("expected unrecorded answer for a different input, but got %+v instead", results[2])
+	}
+}
@@ -0,0 +1,203 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/wlchs/advent_of_code_go_template/internal/registry"
+	"io"
+	"os"
+	"runtime"
+	"slices"
+	"text/tabwriter"
+	"time"
+)
+
+// BenchResult contains the statistics of repeated runs of a single part of a daily challenge
+type BenchResult struct {
+	Year     int           `json:"year"`
+	Day      int           `json:"day"`
+	Part     int           `json:"part"`
+	Runs     int           `json:"runs"`
+	Min      time.Duration `json:"min"`
+	Median   time.Duration `json:"median"`
+	P95      time.Duration `json:"p95"`
+	Allocs   uint64        `json:"allocs"`
+	Bytes    uint64        `json:"bytes"`
+	Err      error         `json:"-"`
+	Baseline time.Duration `json:"-"`
+	// NotApplicable marks a selected part the day doesn't have, such as the second part of day 25
+	NotApplicable bool `json:"-"`
+}
+
+// Change calculates the relative change of the median run time compared to the baseline.
+// It returns 0 if there is no baseline to compare against.
+func (b BenchResult) Change() float64 {
+	if b.Baseline == 0 {
+		return 0
+	}
+	return float64(b.Median-b.Baseline) / float64(b.Baseline)
+}
+
+// Regressed reports whether the median run time grew above the threshold compared to the baseline.
+func (b BenchResult) Regressed(threshold float64) bool {
+	return b.Baseline != 0 && b.Change() > threshold
+}
+
+// BenchmarkChallenges runs every selected part of the given days of a year n times.
+// The inputs are selected by ResolveInput.
+func BenchmarkChallenges(ctx context.Context, year int, days []int, inputDir string, opts RunOptions, n int) []BenchResult {
+	var results []BenchResult
+	for _, day := range days {
+		inputPath, _ := ResolveInput(inputDir, year, day, opts.Days)
+		results = append(results, benchmarkDay(ctx, year, day, inputPath, opts, n)...)
+	}
+	return results
+}
+
+// BenchmarkChallenge runs every selected part of a specific year and day n times with the provided input.
+func BenchmarkChallenge(ctx context.Context, year int, day int, inputPath string, opts RunOptions, n int) []BenchResult {
+	return benchmarkDay(ctx, year, day, inputPath, opts, n)
+}
+
+// benchmarkDay runs every selected part of the day's challenge n times.
+// Selected parts the solver doesn't have are reported as not applicable, like by the runs.
+func benchmarkDay(ctx context.Context, year int, day int, inputPath string, opts RunOptions, n int) []BenchResult {
+	solver, err := registry.Lookup(year, day)
+	if err != nil {
+		return []BenchResult{{Year: year, Day: day, Err: err}}
+	}
+
+	input, err := LoadInputLines(inputPath)
+	if err != nil {
+		return []BenchResult{{Year: year, Day: day, Err: err}}
+	}
+	ctx, opts = opts.forDay(ctx, day)
+
+	var results []BenchResult
+	for _, part := range []int{1, 2} {
+		if !opts.selected(part) {
+			continue
+		}
+		if !slices.Contains(registry.Parts(solver), part) {
+			results = append(results, BenchResult{Year: year, Day: day, Part: part, NotApplicable: true})
+			continue
+		}
+		results = append(results, benchmarkProfiled(ctx, solver, part, input, opts, n))
+	}
+	return results
+}
+
+// benchmarkProfiled benchmarks one part of the challenge while recording the profiles selected in the options.
+// The profiles cover all n runs of the part.
+func benchmarkProfiled(ctx context.Context, solver registry.Solver, part int, input []string, opts RunOptions, n int) BenchResult {
+	if !opts.Profile.Enabled() {
+		return benchmarkPart(ctx, solver, part, input, opts.Timeout, n)
+	}
+
+	stop, err := opts.Profile.start(solver.Year(), solver.Day(), part)
+	if err != nil {
+		return BenchResult{Year: solver.Year(), Day: solver.Day(), Part: part, Err: fmt.Errorf("failed to start profiling: %w", err)}
+	}
+	res := benchmarkPart(ctx, solver, part, input, opts.Timeout, n)
+	if err := stop(); err != nil && res.Err == nil {
+		res.Err = fmt.Errorf("failed to write profiles: %w", err)
+	}
+	return res
+}
+
+// benchmarkPart runs one part of the challenge n times and collects the timing and allocation statistics.
+// The allocations are read from the memory statistics of the whole process, so they're only approximate.
+// The timeout applies to each run separately.
+func benchmarkPart(ctx context.Context, solver registry.Solver, part int, input []string, timeout time.Duration, n int) BenchResult {
+	res := BenchResult{Year: solver.Year(), Day: solver.Day(), Part: part, Runs: n}
+	durations := make([]time.Duration, 0, n)
+
+	var before, after runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+	for i := 0; i < n; i++ {
+		r := solvePart(ctx, solver, part, input, timeout)
+		if r.Err != nil {
+			res.Err = r.Err
+			return res
+		}
+		durations = append(durations, r.Duration)
+	}
+	runtime.ReadMemStats(&after)
+
+	slices.Sort(durations)
+	res.Min = durations[0]
+	res.Median = durations[len(durations)/2]
+	res.P95 = durations[(len(durations)*95+99)/100-1]
+	res.Allocs = (after.Mallocs - before.Mallocs) / uint64(n)
+	res.Bytes = (after.TotalAlloc - before.TotalAlloc) / uint64(n)
+	return res
+}
+
+// SaveBaseline writes the successful benchmark results to the baseline file.
+func SaveBaseline(path string, results []BenchResult) error {
+	var ok []BenchResult
+	for _, r := range results {
+		if r.Err == nil && !r.NotApplicable {
+			ok = append(ok, r)
+		}
+	}
+
+	data, err := json.MarshalIndent(ok, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+// CompareBaseline loads the baseline file and sets the baseline median of the matching results.
+func CompareBaseline(path string, results []BenchResult) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var baseline []BenchResult
+	if err := json.Unmarshal(data, &baseline); err != nil {
+		return fmt.Errorf("failed to parse baseline %s: %w", path, err)
+	}
+
+	for i, r := range results {
+		for _, b := range baseline {
+			if r.Year == b.Year && r.Day == b.Day && r.Part == b.Part {
+				results[i].Baseline = b.Median
+			}
+		}
+	}
+	return nil
+}
+
+// PrintBenchSummary writes the benchmark results as a table.
+// Results that got slower than the baseline by more than the threshold are flagged as regressions.
+func PrintBenchSummary(w io.Writer, results []BenchResult, threshold float64) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "DAY\tPART\tRUNS\tMIN\tMEDIAN\tP95\t~ALLOCS/OP\t~BYTES/OP\tBASELINE\tSTATUS")
+	for _, r := range results {
+		if r.Err != nil {
+			_, _ = fmt.Fprintf(tw, "%d\t%s\t\t\t\t\t\t\t\t%s\n", r.Day, PartLabel(r.Part), r.Err)
+			continue
+		}
+		if r.NotApplicable {
+			_, _ = fmt.Fprintf(tw, "%d\t%d\t\t\t\t\t\t\t\t%s\n", r.Day, r.Part, NotApplicable)
+			continue
+		}
+
+		status := "ok"
+		baseline := "-"
+		if r.Baseline != 0 {
+			baseline = fmt.Sprintf("%v (%+.1f%%)", round(r.Baseline), r.Change()*100)
+		}
+		if r.Regressed(threshold) {
+			status = "regression"
+		}
+		_, _ = fmt.Fprintf(tw, "%d\t%d\t%d\t%v\t%v\t%v\t%d\t%d\t%s\t%s\n",
+			r.Day, r.Part, r.Runs, round(r.Min), round(r.Median), round(r.P95), r.Allocs, r.Bytes, baseline, status)
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,79 @@
+package internal_test
+
+import (
+	"context"
+	"github.com/wlchs/advent_of_code_go_template/internal"
+	_ "github.com/wlchs/advent_of_code_go_template/years"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestBenchmarkChallenge(t *testing.T) {
+	t.Parallel()
+
+	results := internal.BenchmarkChallenge(context.Background(), 2023, 3, "../years/2023/day_03/input_1_test.txt", internal.RunOptions{}, 10)
+	if len(results) != 2 {
+		t.Fatalf("expected 2 results, but got %d instead", len(results))
+	}
+	for _, r := range results {
+		if r.Err != nil || r.Runs != 10 {
+			t.Errorf("expected 10 successful runs, but got %+v instead", r)
+		}
+		if r.Min > r.Median || r.Median > r.P95 {
+			t.Errorf("expected min <= median <= p95, but got %v, %v, %v instead", r.Min, r.Median, r.P95)
+		}
+	}
+}
+
+func TestCompareBaseline(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "baseline.json")
+	baseline := []internal.BenchResult{{Year: 2023, Day: 3, Part: 1, Median: 100}}
+	if err := internal.SaveBaseline(path, baseline); err != nil {
+		t.Fatal(err)
+	}
+
+	results := []internal.BenchResult{
+		{Year: 2023, Day: 3, Part: 1, Median: 150},
+		{Year: 2023, Day: 3, Part: 2, Median: 150},
+	}
+	if err := internal.CompareBaseline(path, results); err != nil {
+		t.Fatal(err)
+	}
+	if !results[0].Regressed(0.2) || results[0].Regressed(0.6) {
+		t.Errorf("expected a 50%% regression, but got %+.2f instead", results[0].Change())
+	}
+	if results[1].Regressed(0) {
+		t.Error("expected no regression without a baseline")
+	}
+}
+
+func TestBenchmarkChallengeNotApplicable(t *testing.T) {
+	t.Parallel()
+
+	opts := internal.RunOptions{Parts: []int{2}}
+	results := internal.BenchmarkChallenge(context.Background(), 2023, 25, "../years/2023/day_25/input_1_test.txt", opts, 1)
+	if len(results) != 1 || !results[0].NotApplicable || results[0].Err != nil || results[0].Part != 2 {
+		t.Fatalf("expected part two to be not applicable, but got %+v instead", results)
+	}
+
+	var sb strings.Builder
+	if err := internal.PrintBenchSummary(&sb, results, 0.1); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(sb.String(), internal.NotApplicable) {
+		t.Errorf("expected the part to be reported as not applicable, but got\n%s", sb.String())
+	}
+
+	path := filepath.Join(t.TempDir(), "baseline.json")
+	if err := internal.SaveBaseline(path, results); err != nil {
+		t.Fatal(err)
+	}
+	baseline, err := os.ReadFile(path)
+	if err != nil || strings.TrimSpace(string(baseline)) != "null" {
+		t.Errorf("expected no baseline for the part, but got %s, %v instead", baseline, err)
+	}
+}
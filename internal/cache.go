@@ -0,0 +1,193 @@
+package internal
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"sort"
+	"sync"
+)
+
+// cacheFile is the name of the answer cache inside the inputs directory
+const cacheFile = "cache.json"
+
+// cacheContent is the format of the answer cache file
+type cacheContent struct {
+	BuildID string         `json:"build_id"`
+	Answers []cachedAnswer `json:"answers"`
+}
+
+// cachedAnswer is an answer of the cache along with the checksum of the solver parameters it was computed with
+type cachedAnswer struct {
+	Answer
+	ParamsSHA256 string `json:"params_sha256,omitempty"`
+}
+
+// cacheKey identifies a cached answer of a part for a specific input and solver parameters
+type cacheKey struct {
+	answerKey
+	params string
+}
+
+// AnswerCache keeps the answers computed by a specific build of the solvers in a local JSON file, so that unchanged
+// parts don't have to be solved again. The answers of other builds are dropped when the cache is loaded.
+// It's safe for concurrent use.
+type AnswerCache struct {
+	path    string
+	buildID string
+	mu      sync.Mutex
+	answers map[cacheKey]string
+	changed bool
+}
+
+// CachePath gives back the default location of the answer cache inside the inputs directory.
+func CachePath(inputDir string) string {
+	return filepath.Join(inputDir, cacheFile)
+}
+
+// BuildID identifies the build of the running binary. The build information alone doesn't change with uncommitted
+// changes of the sources, so the executable itself is hashed along with it.
+func BuildID() (string, error) {
+	h := sha256.New()
+	if info, ok := debug.ReadBuildInfo(); ok {
+		_, _ = io.WriteString(h, info.String())
+	}
+
+	exe, err := os.Executable()
+	if err != nil {
+		return "", fmt.Errorf("failed to identify the build: %w", err)
+	}
+	f, err := os.Open(exe)
+	if err != nil {
+		return "", fmt.Errorf("failed to identify the build: %w", err)
+	}
+	defer func(file *os.File) {
+		_ = file.Close()
+	}(f)
+	if _, err := io.Copy(h, f); err != nil {
+		return "", fmt.Errorf("failed to identify the build: %w", err)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// LoadCache loads the answer cache of the given build from the path.
+// A missing file or one written by another build results in an empty cache, which is created on Save.
+func LoadCache(path string, buildID string) (*AnswerCache, error) {
+	c := &AnswerCache{path: path, buildID: buildID, answers: map[cacheKey]string{}}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return c, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var content cacheContent
+	if err := json.Unmarshal(data, &content); err != nil {
+		return nil, fmt.Errorf("failed to parse cache %s: %w", path, err)
+	}
+	if content.BuildID != buildID {
+		c.changed = true
+		return c, nil
+	}
+	for _, a := range content.Answers {
+		c.answers[cacheKey{answerKey{year: a.Year, day: a.Day, part: a.Part, hash: a.InputSHA256}, a.ParamsSHA256}] = a.Answer.Answer
+	}
+	return c, nil
+}
+
+// Lookup finds the cached answer of the part for the input with the given checksum, solved with the given parameters.
+func (c *AnswerCache) Lookup(year int, day int, part int, hash string, params map[string]string) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	answer, ok := c.answers[cacheKey{answerKey{year: year, day: day, part: part, hash: hash}, paramsHash(params)}]
+	return answer, ok
+}
+
+// Record caches the answer of the part for the input with the given checksum, solved with the given parameters.
+func (c *AnswerCache) Record(year int, day int, part int, hash string, params map[string]string, answer string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.answers[cacheKey{answerKey{year: year, day: day, part: part, hash: hash}, paramsHash(params)}] = answer
+	c.changed = true
+}
+
+// paramsHash calculates a checksum of the solver parameters independent of their order, which is empty without any
+func paramsHash(params map[string]string) string {
+	if len(params) == 0 {
+		return ""
+	}
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	h := sha256.New()
+	for _, name := range names {
+		// the lengths keep the boundaries of the names and values unambiguous
+		_, _ = fmt.Fprintf(h, "%d:%s=%d:%s\n", len(name), name, len(params[name]), params[name])
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Save writes the cached answers to the cache's file ordered by year, day and part, if any of them changed.
+func (c *AnswerCache) Save() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.changed {
+		return nil
+	}
+
+	content := cacheContent{BuildID: c.buildID, Answers: make([]cachedAnswer, 0, len(c.answers))}
+	for k, v := range c.answers {
+		content.Answers = append(content.Answers, cachedAnswer{
+			Answer:       Answer{Year: k.year, Day: k.day, Part: k.part, InputSHA256: k.hash, Answer: v},
+			ParamsSHA256: k.params,
+		})
+	}
+	sort.Slice(content.Answers, func(i, j int) bool {
+		a, b := content.Answers[i], content.Answers[j]
+		if a.Year != b.Year {
+			return a.Year < b.Year
+		}
+		if a.Day != b.Day {
+			return a.Day < b.Day
+		}
+		if a.Part != b.Part {
+			return a.Part < b.Part
+		}
+		if a.InputSHA256 != b.InputSHA256 {
+			return a.InputSHA256 < b.InputSHA256
+		}
+		return a.ParamsSHA256 < b.ParamsSHA256
+	})
+
+	data, err := json.MarshalIndent(content, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(c.path, append(data, '\n'), 0o644); err != nil {
+		return err
+	}
+	c.changed = false
+	return nil
+}
+
+// ClearCache removes the answer cache at the given path. The returned flag reports whether there was one.
+func ClearCache(path string) (bool, error) {
+	err := os.Remove(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	}
+	return err == nil, err
+}
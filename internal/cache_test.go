@@ -0,0 +1,138 @@
+package internal_test
+
+import (
+	"context"
+	"github.com/wlchs/advent_of_code_go_template/internal"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestAnswerCache(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "2023"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	example, err := os.ReadFile("../years/2023/day_01/input_1_test.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(internal.InputPath(dir, 2023, 1), example, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	path := internal.CachePath(dir)
+	cache, err := internal.LoadCache(path, "build")
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts := internal.RunOptions{Parts: []int{1}, Cache: cache}
+	results := internal.RunChallenges(context.Background(), 2023, []int{1}, dir, opts)
+	if len(results) != 1 || results[0].Answer != "142" || results[0].Cached {
+		t.Fatalf("expected a solved answer, but got %+v instead", results)
+	}
+	if err := cache.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	// a cached answer is taken as it is, without running the solver
+	cache, err = internal.LoadCache(path, "build")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if answer, ok := cache.Lookup(2023, 1, 1, results[0].InputSHA256, nil); !ok || answer != "142" {
+		t.Fatalf("expected the answer to be cached, but got %q, %t instead", answer, ok)
+	}
+	cache.Record(2023, 1, 1, results[0].InputSHA256, nil, "cached")
+	opts.Cache = cache
+	results = internal.RunChallenges(context.Background(), 2023, []int{1}, dir, opts)
+	if len(results) != 1 || results[0].Answer != "cached" || !results[0].Cached || results[0].Status() != "ok (cached)" {
+		t.Errorf("expected a cache hit, but got %+v instead", results)
+	}
+
+	// another build doesn't see the answers
+	cache, err = internal.LoadCache(path, "other build")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := cache.Lookup(2023, 1, 1, results[0].InputSHA256, nil); ok {
+		t.Error("expected the answers of another build to be dropped")
+	}
+
+	if removed, err := internal.ClearCache(path); !removed || err != nil {
+		t.Errorf("expected the cache to be removed, but got %t, %v instead", removed, err)
+	}
+	if removed, err := internal.ClearCache(path); removed || err != nil {
+		t.Errorf("expected a missing cache to be ignored, but got %t, %v instead", removed, err)
+	}
+}
+
+func TestAnswerCacheParams(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "2023"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	example, err := os.ReadFile("../years/2023/day_21/input_1_test.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(internal.InputPath(dir, 2023, 21), example, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	cache, err := internal.LoadCache(internal.CachePath(dir), "build")
+	if err != nil {
+		t.Fatal(err)
+	}
+	run := func(steps string) internal.Result {
+		opts := internal.RunOptions{
+			Parts: []int{1},
+			Cache: cache,
+			Days:  map[int]internal.DayOptions{21: {Params: map[string]string{"steps": steps}}},
+		}
+		results := internal.RunChallenges(context.Background(), 2023, []int{21}, dir, opts)
+		if len(results) != 1 || results[0].Err != nil {
+			t.Fatalf("unexpected results %+v", results)
+		}
+		return results[0]
+	}
+
+	if r := run("6"); r.Answer != "16" || r.Cached {
+		t.Errorf("expected a solved answer of 16, but got %+v instead", r)
+	}
+	if r := run("6"); r.Answer != "16" || !r.Cached {
+		t.Errorf("expected a cache hit with the same parameters, but got %+v instead", r)
+	}
+	// changing a parameter must not give back the answer of the previous one
+	if r := run("10"); r.Answer != "33" || r.Cached {
+		t.Errorf("expected a cache miss solving 33, but got %+v instead", r)
+	}
+	if err := cache.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	cache, err = internal.LoadCache(internal.CachePath(dir), "build")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r := run("10"); r.Answer != "33" || !r.Cached {
+		t.Errorf("expected the parameters to be saved with the answer, but got %+v instead", r)
+	}
+}
+
+func TestBuildID(t *testing.T) {
+	t.Parallel()
+
+	first, err := internal.BuildID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := internal.BuildID()
+	if err != nil || first == "" || first != second {
+		t.Errorf("expected a stable build ID, but got %q and %q, %v instead", first, second, err)
+	}
+}
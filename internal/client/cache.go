@@ -0,0 +1,127 @@
+package client
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+)
+
+// WrongAnswer is a rejected answer of a single part of a daily challenge
+type WrongAnswer struct {
+	Year int `json:"year"`
+	Day  int `json:"day"`
+	Part int `json:"part"`
+	// SessionSHA256 is the checksum of the session the answer was rejected for, as every user has different inputs
+	SessionSHA256 string  `json:"session_sha256"`
+	Answer        string  `json:"answer"`
+	Outcome       Outcome `json:"outcome"`
+}
+
+// WrongAnswers keeps the rejected answers in a local JSON file, so that they are never submitted again
+type WrongAnswers struct {
+	path    string
+	answers []WrongAnswer
+}
+
+// LoadWrongAnswers loads the wrong answers cache from the given path.
+// A missing file or an empty path results in an empty cache.
+func LoadWrongAnswers(path string) (*WrongAnswers, error) {
+	c := &WrongAnswers{path: path}
+	if path == "" {
+		return c, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return c, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &c.answers); err != nil {
+		return nil, fmt.Errorf("failed to parse wrong answers %s: %w", path, err)
+	}
+	return c, nil
+}
+
+// Lookup checks whether the answer of the part is known to be wrong for the given session.
+// Besides the answers rejected before, numbers at or above a too high answer and at or below a too low answer
+// are reported as wrong too.
+func (c *WrongAnswers) Lookup(year int, day int, part int, session string, answer string) (Verdict, bool) {
+	n, numeric := parseNumber(answer)
+	hash := sessionHash(session)
+	for _, w := range c.answers {
+		if w.Year != year || w.Day != day || w.Part != part || w.SessionSHA256 != hash {
+			continue
+		}
+		if w.Answer == answer {
+			return Verdict{Outcome: w.Outcome, Cached: true}, true
+		}
+
+		bound, ok := parseNumber(w.Answer)
+		if !numeric || !ok {
+			continue
+		}
+		if w.Outcome == TooHigh && n >= bound {
+			return Verdict{Outcome: TooHigh, Cached: true}, true
+		}
+		if w.Outcome == TooLow && n <= bound {
+			return Verdict{Outcome: TooLow, Cached: true}, true
+		}
+	}
+	return Verdict{}, false
+}
+
+// Record stores the rejected answer of the part for the given session.
+func (c *WrongAnswers) Record(year int, day int, part int, session string, answer string, outcome Outcome) {
+	c.answers = append(c.answers, WrongAnswer{
+		Year:          year,
+		Day:           day,
+		Part:          part,
+		SessionSHA256: sessionHash(session),
+		Answer:        answer,
+		Outcome:       outcome,
+	})
+}
+
+// Save writes the wrong answers to the cache file ordered by year, day and part.
+func (c *WrongAnswers) Save() error {
+	sort.SliceStable(c.answers, func(i, j int) bool {
+		a, b := c.answers[i], c.answers[j]
+		if a.Year != b.Year {
+			return a.Year < b.Year
+		}
+		if a.Day != b.Day {
+			return a.Day < b.Day
+		}
+		return a.Part < b.Part
+	})
+
+	data, err := json.MarshalIndent(c.answers, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(c.path, append(data, '\n'), 0o644)
+}
+
+// sessionHash calculates the checksum of the session cookie, so that the cookie itself is never written to the cache
+func sessionHash(session string) string {
+	sum := sha256.Sum256([]byte(session))
+	return hex.EncodeToString(sum[:])
+}
+
+// parseNumber parses a numeric answer
+func parseNumber(answer string) (int64, bool) {
+	n, err := strconv.ParseInt(answer, 10, 64)
+	return n, err == nil
+}
@@ -0,0 +1,170 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/wlchs/advent_of_code_go_template/internal"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// DefaultBaseURL is the address of the puzzle site.
+const DefaultBaseURL = "https://adventofcode.com"
+
+// SessionEnv is the environment variable holding the session cookie of the puzzle site.
+const SessionEnv = "AOC_SESSION"
+
+// BaseURLEnv is the environment variable that overrides the address of the puzzle site.
+const BaseURLEnv = "AOC_BASE_URL"
+
+// userAgent identifies the client towards the puzzle site, as requested by its maintainers
+const userAgent = "github.com/wlchs/advent_of_code_go_template"
+
+// wrongAnswersFile is the name of the wrong answers cache inside the inputs directory
+const wrongAnswersFile = "wrong_answers.json"
+
+// ErrMissingSession is returned when a request requires a session cookie, but none is configured.
+var ErrMissingSession = errors.New("missing session cookie, set it with --session or $" + SessionEnv)
+
+// ErrUnexpectedResponse is returned when the puzzle site responds with something the client doesn't understand.
+var ErrUnexpectedResponse = errors.New("unexpected response")
+
+// Options of the client
+type Options struct {
+	// BaseURL is the address of the puzzle site, DefaultBaseURL is used if it's empty
+	BaseURL string
+	// Session is the value of the session cookie of the logged-in user
+	Session string
+	// WrongAnswersPath is the cache of the rejected answers, they are never submitted again
+	WrongAnswersPath string
+	// HTTPClient sends the requests, http.DefaultClient is used if it's nil
+	HTTPClient *http.Client
+}
+
+// Client downloads the inputs from the puzzle site and submits the answers
+type Client struct {
+	opts Options
+}
+
+// New creates a client with the given options.
+func New(opts Options) *Client {
+	if opts.BaseURL == "" {
+		opts.BaseURL = DefaultBaseURL
+	}
+	opts.BaseURL = strings.TrimSuffix(opts.BaseURL, "/")
+	if opts.HTTPClient == nil {
+		opts.HTTPClient = http.DefaultClient
+	}
+	return &Client{opts: opts}
+}
+
+// WrongAnswersPath gives back the default location of the wrong answers cache inside the inputs directory.
+func WrongAnswersPath(inputDir string) string {
+	return filepath.Join(inputDir, wrongAnswersFile)
+}
+
+// FetchInput downloads the input of a specific year and day.
+func (c *Client) FetchInput(ctx context.Context, year int, day int) ([]byte, error) {
+	resp, err := c.do(ctx, http.MethodGet, fmt.Sprintf("/%d/day/%d/input", year, day), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w %s for %d day %d: %s", ErrUnexpectedResponse, resp.Status, year, day, strings.TrimSpace(string(body)))
+	}
+	return body, nil
+}
+
+// DownloadInput saves the input of a specific year and day to the inputs directory following the
+// inputs/2023/day_05.txt naming convention, and gives back its path.
+// Inputs that are already present are never downloaded again.
+func (c *Client) DownloadInput(ctx context.Context, inputDir string, year int, day int) (string, error) {
+	if path, ok := internal.FindInput(inputDir, year, day); ok {
+		return path, nil
+	}
+
+	data, err := c.FetchInput(ctx, year, day)
+	if err != nil {
+		return "", err
+	}
+
+	path := internal.InputPath(inputDir, year, day)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return "", err
+	}
+	return path, os.WriteFile(path, data, 0o644)
+}
+
+// Submit sends the answer of a part to the puzzle site and gives back its verdict.
+// Answers that are known to be wrong from the cache, including numbers outside the bounds set by earlier too high
+// and too low answers, aren't sent again; their verdict is answered from the cache instead.
+func (c *Client) Submit(ctx context.Context, year int, day int, part int, answer string) (Verdict, error) {
+	cache, err := LoadWrongAnswers(c.opts.WrongAnswersPath)
+	if err != nil {
+		return Verdict{}, err
+	}
+	if v, ok := cache.Lookup(year, day, part, c.opts.Session, answer); ok {
+		return v, nil
+	}
+
+	form := url.Values{"level": {strconv.Itoa(part)}, "answer": {answer}}
+	resp, err := c.do(ctx, http.MethodPost, fmt.Sprintf("/%d/day/%d/answer", year, day), strings.NewReader(form.Encode()))
+	if err != nil {
+		return Verdict{}, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return Verdict{}, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return Verdict{}, fmt.Errorf("%w %s for %d day %d part %d", ErrUnexpectedResponse, resp.Status, year, day, part)
+	}
+
+	v, err := ParseVerdict(string(body))
+	if err != nil {
+		return Verdict{}, err
+	}
+	if v.Wrong() && c.opts.WrongAnswersPath != "" {
+		cache.Record(year, day, part, c.opts.Session, answer, v.Outcome)
+		if err := cache.Save(); err != nil {
+			return v, err
+		}
+	}
+	return v, nil
+}
+
+// do sends an authenticated request to the puzzle site
+func (c *Client) do(ctx context.Context, method string, path string, body io.Reader) (*http.Response, error) {
+	if c.opts.Session == "" {
+		return nil, ErrMissingSession
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.opts.BaseURL+path, body)
+	if err != nil {
+		return nil, err
+	}
+	req.AddCookie(&http.Cookie{Name: "session", Value: c.opts.Session})
+	req.Header.Set("User-Agent", userAgent)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+	return c.opts.HTTPClient.Do(req)
+}
@@ -0,0 +1,153 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"github.com/wlchs/advent_of_code_go_template/internal"
+	"github.com/wlchs/advent_of_code_go_template/internal/client"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// responses of the puzzle site stand-in keyed by the submitted answer
+var responses = map[string]string{
+	"42":  `<main><article><p>That's the right answer! You are <em>one gold star</em> closer.</p></article></main>`,
+	"100": `<main><article><p>That's not the right answer; your answer is too high. Please wait one minute before trying again.</p></article></main>`,
+	"10":  `<main><article><p>That's not the right answer; your answer is too low.</p></article></main>`,
+	"abc": `<main><article><p>That's not the right answer. Please wait 5 minutes before trying again.</p></article></main>`,
+	"50":  `<main><article><p>You gave an answer too recently; you have to wait after submitting an answer before trying again. You have 4m 30s left to wait.</p></article></main>`,
+}
+
+// standIn starts a puzzle site stand-in and counts the submitted answers
+func standIn(t *testing.T, submissions *atomic.Int32) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/2023/day/5/input", func(w http.ResponseWriter, r *http.Request) {
+		if c, err := r.Cookie("session"); err != nil || c.Value != "secret" {
+			http.Error(w, "Puzzle inputs differ by user. Please log in to get your puzzle input.", http.StatusBadRequest)
+			return
+		}
+		_, _ = w.Write([]byte("seeds: 79 14 55 13\n"))
+	})
+	mux.HandleFunc("/2023/day/5/answer", func(w http.ResponseWriter, r *http.Request) {
+		submissions.Add(1)
+		if r.Method != http.MethodPost || r.FormValue("level") != "1" {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+		_, _ = w.Write([]byte(responses[r.FormValue("answer")]))
+	})
+
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestDownloadInput(t *testing.T) {
+	t.Parallel()
+
+	var submissions atomic.Int32
+	srv := standIn(t, &submissions)
+	dir := t.TempDir()
+
+	if _, err := client.New(client.Options{BaseURL: srv.URL}).DownloadInput(context.Background(), dir, 2023, 5); !errors.Is(err, client.ErrMissingSession) {
+		t.Errorf("expected ErrMissingSession, but got %v instead", err)
+	}
+	if _, err := client.New(client.Options{BaseURL: srv.URL, Session: "wrong"}).DownloadInput(context.Background(), dir, 2023, 5); !errors.Is(err, client.ErrUnexpectedResponse) {
+		t.Errorf("expected ErrUnexpectedResponse, but got %v instead", err)
+	}
+
+	c := client.New(client.Options{BaseURL: srv.URL + "/", Session: "secret"})
+	path, err := c.DownloadInput(context.Background(), dir, 2023, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if path != internal.InputPath(dir, 2023, 5) {
+		t.Errorf("unexpected input path %s", path)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil || string(data) != "seeds: 79 14 55 13\n" {
+		t.Errorf("unexpected input %q, %v", data, err)
+	}
+
+	// the existing input isn't downloaded again, even with an invalid session
+	c = client.New(client.Options{BaseURL: srv.URL, Session: "wrong"})
+	if _, err := c.DownloadInput(context.Background(), dir, 2023, 5); err != nil {
+		t.Errorf("expected the existing input to be kept, but got %v instead", err)
+	}
+}
+
+func TestSubmit(t *testing.T) {
+	t.Parallel()
+
+	var submissions atomic.Int32
+	srv := standIn(t, &submissions)
+	wrongAnswers := client.WrongAnswersPath(t.TempDir())
+	c := client.New(client.Options{BaseURL: srv.URL, Session: "secret", WrongAnswersPath: wrongAnswers})
+
+	tests := []struct {
+		answer  string
+		outcome client.Outcome
+		wait    time.Duration
+		cached  bool
+	}{
+		{answer: "100", outcome: client.TooHigh, wait: time.Minute},
+		{answer: "10", outcome: client.TooLow},
+		{answer: "abc", outcome: client.Wrong, wait: 5 * time.Minute},
+		{answer: "50", outcome: client.RateLimited, wait: 4*time.Minute + 30*time.Second},
+		{answer: "42", outcome: client.Accepted},
+		// known wrong answers and numbers outside the known bounds aren't submitted again
+		{answer: "100", outcome: client.TooHigh, cached: true},
+		{answer: "150", outcome: client.TooHigh, cached: true},
+		{answer: "3", outcome: client.TooLow, cached: true},
+		{answer: "abc", outcome: client.Wrong, cached: true},
+	}
+	for _, tt := range tests {
+		before := submissions.Load()
+		v, err := c.Submit(context.Background(), 2023, 5, 1, tt.answer)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v.Outcome != tt.outcome || v.Wait != tt.wait || v.Cached != tt.cached {
+			t.Errorf("expected %s, %v, cached %t for %s, but got %+v instead", tt.outcome, tt.wait, tt.cached, tt.answer, v)
+		}
+		if submitted := submissions.Load() != before; submitted == tt.cached {
+			t.Errorf("expected answer %s to be submitted: %t", tt.answer, !tt.cached)
+		}
+	}
+
+	cache, err := client.LoadWrongAnswers(wrongAnswers)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := cache.Lookup(2023, 5, 1, "secret", "42"); ok {
+		t.Error("expected the accepted answer to be missing from the wrong answers")
+	}
+
+	// answers rejected for another user are submitted again
+	other := client.New(client.Options{BaseURL: srv.URL, Session: "other", WrongAnswersPath: wrongAnswers})
+	before := submissions.Load()
+	if v, err := other.Submit(context.Background(), 2023, 5, 1, "100"); err != nil || v.Cached {
+		t.Errorf("expected the answer of another session not to be cached, but got %+v, %v instead", v, err)
+	}
+	if submissions.Load() == before {
+		t.Error("expected the answer of another session to be submitted")
+	}
+}
+
+func TestParseVerdict(t *testing.T) {
+	t.Parallel()
+
+	v, err := client.ParseVerdict(`<article><p>You don't seem to be solving the right level. Did you already complete it?</p></article>`)
+	if err != nil || v.Outcome != client.AlreadySolved {
+		t.Errorf("expected already solved, but got %+v, %v instead", v, err)
+	}
+	if _, err := client.ParseVerdict(`<html>maintenance</html>`); !errors.Is(err, client.ErrUnexpectedResponse) {
+		t.Errorf("expected ErrUnexpectedResponse, but got %v instead", err)
+	}
+}
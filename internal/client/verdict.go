@@ -0,0 +1,100 @@
+package client
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Outcome of an answer submission
+type Outcome string
+
+// Outcomes reported by the puzzle site
+const (
+	Accepted      Outcome = "accepted"
+	Wrong         Outcome = "wrong"
+	TooHigh       Outcome = "too high"
+	TooLow        Outcome = "too low"
+	RateLimited   Outcome = "rate limited"
+	AlreadySolved Outcome = "already solved"
+)
+
+// Verdict is the response of the puzzle site to a submitted answer
+type Verdict struct {
+	Outcome Outcome
+	// Wait is the time to wait before the next submission, if the puzzle site asked for it
+	Wait time.Duration
+	// Message is the text of the response
+	Message string
+	// Cached is set if the answer was not submitted, because it's known to be wrong
+	Cached bool
+}
+
+// Wrong reports whether the answer was rejected as incorrect.
+func (v Verdict) Wrong() bool {
+	return v.Outcome == Wrong || v.Outcome == TooHigh || v.Outcome == TooLow
+}
+
+// String describes the verdict in a short human-readable form.
+func (v Verdict) String() string {
+	s := string(v.Outcome)
+	if v.Cached {
+		s += " (cached, not submitted)"
+	}
+	if v.Wait > 0 {
+		s += fmt.Sprintf(", wait %v", v.Wait)
+	}
+	return s
+}
+
+// article matches the main content of the response page
+var article = regexp.MustCompile(`(?s)<article[^>]*>(.*?)</article>`)
+
+// tag matches the HTML tags of the response
+var tag = regexp.MustCompile(`<[^>]+>`)
+
+// leftToWait matches the remaining time of a rate limited submission, e.g. "You have 4m 30s left to wait"
+var leftToWait = regexp.MustCompile(`You have (?:(\d+)m )?(\d+)s left to wait`)
+
+// waitMinutes matches the time to wait after a wrong answer, e.g. "please wait one minute" or "wait 5 minutes"
+var waitMinutes = regexp.MustCompile(`wait (one|\d+) minutes?`)
+
+// ParseVerdict extracts the verdict from the response page of an answer submission.
+func ParseVerdict(page string) (Verdict, error) {
+	message := page
+	if m := article.FindStringSubmatch(page); m != nil {
+		message = m[1]
+	}
+	message = strings.Join(strings.Fields(tag.ReplaceAllString(message, "")), " ")
+	v := Verdict{Message: message}
+
+	switch {
+	case strings.Contains(message, "That's the right answer"):
+		v.Outcome = Accepted
+	case strings.Contains(message, "That's not the right answer"):
+		v.Outcome = Wrong
+		if strings.Contains(message, "too high") {
+			v.Outcome = TooHigh
+		} else if strings.Contains(message, "too low") {
+			v.Outcome = TooLow
+		}
+		if m := waitMinutes.FindStringSubmatch(message); m != nil {
+			minutes, _ := strconv.Atoi(m[1])
+			v.Wait = time.Duration(max(minutes, 1)) * time.Minute
+		}
+	case strings.Contains(message, "You gave an answer too recently"):
+		v.Outcome = RateLimited
+		if m := leftToWait.FindStringSubmatch(message); m != nil {
+			minutes, _ := strconv.Atoi(m[1])
+			seconds, _ := strconv.Atoi(m[2])
+			v.Wait = time.Duration(minutes)*time.Minute + time.Duration(seconds)*time.Second
+		}
+	case strings.Contains(message, "You don't seem to be solving the right level"):
+		v.Outcome = AlreadySolved
+	default:
+		return v, fmt.Errorf("%w: %q", ErrUnexpectedResponse, message)
+	}
+	return v, nil
+}
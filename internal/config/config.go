@@ -0,0 +1,265 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"github.com/BurntSushi/toml"
+	"github.com/wlchs/advent_of_code_go_template/internal"
+	"github.com/wlchs/advent_of_code_go_template/internal/registry"
+	"io"
+	"io/fs"
+	"sort"
+	"strconv"
+	"text/tabwriter"
+	"time"
+)
+
+// DefaultPath is the configuration file looked up in the working directory if no other one is selected.
+const DefaultPath = "aoc.toml"
+
+// environment variables overriding the configuration file
+const (
+	PathEnv    = "AOC_CONFIG"
+	YearEnv    = "AOC_YEAR"
+	OutputEnv  = "AOC_OUTPUT"
+	TimeoutEnv = "AOC_TIMEOUT"
+	JobsEnv    = "AOC_JOBS"
+	CacheEnv   = "AOC_CACHE"
+)
+
+// sources of the resolved settings
+const (
+	SourceFlag    = "flag"
+	SourceEnv     = "env"
+	SourceFile    = "config"
+	SourceDefault = "default"
+)
+
+// ErrInvalidConfig is returned when a setting can't be parsed.
+var ErrInvalidConfig = errors.New("invalid config")
+
+// File is the content of the configuration file, e.g.
+//
+//	inputs = "inputs"
+//	year = 2023
+//	output = "text"
+//	timeout = "30s"
+//	jobs = 4
+//	cache = true
+//
+//	[days.2023.21]
+//	timeout = "1m"
+//	params = { steps = 6 }
+type File struct {
+	// Path of the loaded file, empty if there is none
+	Path    string                    `toml:"-"`
+	Inputs  string                    `toml:"inputs"`
+	Year    int                       `toml:"year"`
+	Output  string                    `toml:"output"`
+	Timeout string                    `toml:"timeout"`
+	Jobs    *int                      `toml:"jobs"`
+	Cache   bool                      `toml:"cache"`
+	Days    map[string]map[string]Day `toml:"days"`
+}
+
+// Day contains the overrides of a specific day in the configuration file
+type Day struct {
+	Input   string         `toml:"input"`
+	Timeout string         `toml:"timeout"`
+	Params  map[string]any `toml:"params"`
+}
+
+// Settings are the resolved settings of a run
+type Settings struct {
+	// Path of the loaded configuration file, empty if there is none
+	Path    string
+	Inputs  string
+	Year    int
+	Output  internal.Format
+	Timeout time.Duration
+	Jobs    int
+	// Cache enables the answer cache, see internal.AnswerCache
+	Cache bool
+	// Days are the overrides of specific days keyed by year and day
+	Days map[int]map[int]internal.DayOptions
+	// Sources tell where each of the settings comes from: a flag, an environment variable, the file or the defaults
+	Sources map[string]string
+}
+
+// Load reads the configuration file from the given path.
+// A missing file results in an empty configuration unless required is set.
+func Load(path string, required bool) (File, error) {
+	var f File
+	_, err := toml.DecodeFile(path, &f)
+	if errors.Is(err, fs.ErrNotExist) && !required {
+		return File{}, nil
+	}
+	if err != nil {
+		return File{}, fmt.Errorf("failed to load config %s: %w", path, err)
+	}
+	f.Path = path
+	return f, nil
+}
+
+// Resolve combines the explicitly set flags, the environment variables, the configuration file and the defaults
+// in this order of precedence. The flags are keyed by their names: inputs, year, output, timeout, jobs and no-cache,
+// which disables the otherwise opt-in cache.
+func Resolve(f File, flags map[string]string, getenv func(string) string) (Settings, error) {
+	s := Settings{Path: f.Path, Sources: map[string]string{}}
+	lookup := func(name string, env string, file string) (string, string) {
+		if v, ok := flags[name]; ok {
+			return v, SourceFlag
+		}
+		if v := getenv(env); v != "" {
+			return v, SourceEnv
+		}
+		if file != "" {
+			return file, SourceFile
+		}
+		return "", SourceDefault
+	}
+
+	var fileYear, fileJobs string
+	if f.Year != 0 {
+		fileYear = strconv.Itoa(f.Year)
+	}
+	if f.Jobs != nil {
+		fileJobs = strconv.Itoa(*f.Jobs)
+	}
+
+	var value string
+	var err error
+	value, s.Sources["inputs"] = lookup("inputs", internal.InputsDirEnv, f.Inputs)
+	s.Inputs = internal.ResolveInputsDir(value)
+
+	value, s.Sources["year"] = lookup("year", YearEnv, fileYear)
+	s.Year = registry.LatestYear()
+	if value != "" {
+		if s.Year, err = strconv.Atoi(value); err != nil {
+			return Settings{}, fmt.Errorf("%w year %q", ErrInvalidConfig, value)
+		}
+	}
+
+	value, s.Sources["output"] = lookup("output", OutputEnv, f.Output)
+	s.Output = internal.FormatText
+	if value != "" {
+		if s.Output, err = internal.ParseFormat(value); err != nil {
+			return Settings{}, fmt.Errorf("%w: %w", ErrInvalidConfig, err)
+		}
+	}
+
+	value, s.Sources["timeout"] = lookup("timeout", TimeoutEnv, f.Timeout)
+	if value != "" {
+		if s.Timeout, err = time.ParseDuration(value); err != nil || s.Timeout < 0 {
+			return Settings{}, fmt.Errorf("%w timeout %q", ErrInvalidConfig, value)
+		}
+	}
+
+	value, s.Sources["jobs"] = lookup("jobs", JobsEnv, fileJobs)
+	s.Jobs = 1
+	if value != "" {
+		if s.Jobs, err = strconv.Atoi(value); err != nil || s.Jobs < 0 {
+			return Settings{}, fmt.Errorf("%w jobs %q", ErrInvalidConfig, value)
+		}
+	}
+
+	var fileCache string
+	if f.Cache {
+		fileCache = "true"
+	}
+	if v, ok := flags["no-cache"]; ok {
+		noCache, err := strconv.ParseBool(v)
+		if err != nil {
+			return Settings{}, fmt.Errorf("%w no-cache %q", ErrInvalidConfig, v)
+		}
+		s.Cache, s.Sources["cache"] = !noCache, SourceFlag
+	} else {
+		value, s.Sources["cache"] = lookup("cache", CacheEnv, fileCache)
+		if value != "" {
+			if s.Cache, err = strconv.ParseBool(value); err != nil {
+				return Settings{}, fmt.Errorf("%w cache %q", ErrInvalidConfig, value)
+			}
+		}
+	}
+
+	s.Days, err = resolveDays(f.Days)
+	return s, err
+}
+
+// resolveDays parses the overrides of the days in the configuration file
+func resolveDays(days map[string]map[string]Day) (map[int]map[int]internal.DayOptions, error) {
+	res := map[int]map[int]internal.DayOptions{}
+	for y, byDay := range days {
+		year, err := strconv.Atoi(y)
+		if err != nil {
+			return nil, fmt.Errorf("%w year %q in days", ErrInvalidConfig, y)
+		}
+		res[year] = map[int]internal.DayOptions{}
+		for d, day := range byDay {
+			n, err := strconv.Atoi(d)
+			if err != nil || n < 1 || n > 25 {
+				return nil, fmt.Errorf("%w day %q of %d", ErrInvalidConfig, d, year)
+			}
+
+			opts := internal.DayOptions{Input: day.Input, Params: map[string]string{}}
+			if day.Timeout != "" {
+				if opts.Timeout, err = time.ParseDuration(day.Timeout); err != nil || opts.Timeout < 0 {
+					return nil, fmt.Errorf("%w timeout %q of %d day %d", ErrInvalidConfig, day.Timeout, year, n)
+				}
+			}
+			for name, value := range day.Params {
+				opts.Params[name] = fmt.Sprint(value)
+			}
+			res[year][n] = opts
+		}
+	}
+	return res, nil
+}
+
+// Print writes the resolved settings and their sources as a table, followed by the overrides of the days.
+func Print(w io.Writer, s Settings) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	path := s.Path
+	if path == "" {
+		path = "-"
+	}
+	_, _ = fmt.Fprintf(tw, "CONFIG\t%s\t\n", path)
+	_, _ = fmt.Fprintln(tw, "SETTING\tVALUE\tSOURCE")
+	_, _ = fmt.Fprintf(tw, "inputs\t%s\t%s\n", s.Inputs, s.Sources["inputs"])
+	_, _ = fmt.Fprintf(tw, "year\t%d\t%s\n", s.Year, s.Sources["year"])
+	_, _ = fmt.Fprintf(tw, "output\t%s\t%s\n", s.Output, s.Sources["output"])
+	_, _ = fmt.Fprintf(tw, "timeout\t%v\t%s\n", s.Timeout, s.Sources["timeout"])
+	_, _ = fmt.Fprintf(tw, "jobs\t%d\t%s\n", s.Jobs, s.Sources["jobs"])
+	_, _ = fmt.Fprintf(tw, "cache\t%t\t%s\n", s.Cache, s.Sources["cache"])
+
+	var years []int
+	for year := range s.Days {
+		years = append(years, year)
+	}
+	sort.Ints(years)
+	for _, year := range years {
+		var days []int
+		for day := range s.Days[year] {
+			days = append(days, day)
+		}
+		sort.Ints(days)
+		for _, day := range days {
+			d := s.Days[year][day]
+			if d.Input != "" {
+				_, _ = fmt.Fprintf(tw, "days.%d.%d.input\t%s\t%s\n", year, day, d.Input, SourceFile)
+			}
+			if d.Timeout != 0 {
+				_, _ = fmt.Fprintf(tw, "days.%d.%d.timeout\t%v\t%s\n", year, day, d.Timeout, SourceFile)
+			}
+			var names []string
+			for name := range d.Params {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			for _, name := range names {
+				_, _ = fmt.Fprintf(tw, "days.%d.%d.params.%s\t%s\t%s\n", year, day, name, d.Params[name], SourceFile)
+			}
+		}
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,143 @@
+package config_test
+
+import (
+	"errors"
+	"github.com/wlchs/advent_of_code_go_template/internal"
+	"github.com/wlchs/advent_of_code_go_template/internal/config"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+const content = `
+inputs = "from_file"
+year = 2022
+output = "json"
+timeout = "30s"
+jobs = 4
+cache = true
+
+[days.2023.21]
+input = "inputs/2023/day_21_example.txt"
+timeout = "1m"
+params = { steps = 6 }
+`
+
+// load writes the content into a configuration file and loads it
+func load(t *testing.T, content string) config.File {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), config.DefaultPath)
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	f, err := config.Load(path, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return f
+}
+
+func TestResolvePrecedence(t *testing.T) {
+	t.Parallel()
+
+	f := load(t, content)
+	env := map[string]string{config.OutputEnv: "csv", config.JobsEnv: "2", internal.InputsDirEnv: "from_env"}
+	flags := map[string]string{"jobs": "8"}
+
+	s, err := config.Resolve(f, flags, func(name string) string { return env[name] })
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if s.Inputs != "from_env" || s.Year != 2022 || s.Output != internal.FormatCSV || s.Timeout != 30*time.Second || s.Jobs != 8 || !s.Cache {
+		t.Errorf("unexpected settings %+v", s)
+	}
+	expected := map[string]string{
+		"inputs":  config.SourceEnv,
+		"year":    config.SourceFile,
+		"output":  config.SourceEnv,
+		"timeout": config.SourceFile,
+		"jobs":    config.SourceFlag,
+		"cache":   config.SourceFile,
+	}
+	if !reflect.DeepEqual(s.Sources, expected) {
+		t.Errorf("expected sources %v, but got %v instead", expected, s.Sources)
+	}
+
+	// the cache is opt-in, but a flag disables it
+	s, err = config.Resolve(f, map[string]string{"no-cache": "true"}, func(string) string { return "" })
+	if err != nil || s.Cache || s.Sources["cache"] != config.SourceFlag {
+		t.Errorf("expected the flag to disable the cache, but got %t from %s, %v instead", s.Cache, s.Sources["cache"], err)
+	}
+}
+
+func TestResolveDefaults(t *testing.T) {
+	t.Parallel()
+
+	f, err := config.Load(filepath.Join(t.TempDir(), config.DefaultPath), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := config.Resolve(f, nil, func(string) string { return "" })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Path != "" || s.Inputs != "inputs" || s.Output != internal.FormatText || s.Timeout != 0 || s.Jobs != 1 || s.Cache {
+		t.Errorf("unexpected settings %+v", s)
+	}
+	for name, source := range s.Sources {
+		if source != config.SourceDefault {
+			t.Errorf("expected %s to be a default, but it comes from %s", name, source)
+		}
+	}
+
+	if _, err := config.Load(filepath.Join(t.TempDir(), "missing.toml"), true); err == nil {
+		t.Error("expected an error for an explicitly selected missing file")
+	}
+}
+
+func TestResolveDays(t *testing.T) {
+	t.Parallel()
+
+	s, err := config.Resolve(load(t, content), nil, func(string) string { return "" })
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := internal.DayOptions{
+		Input:   "inputs/2023/day_21_example.txt",
+		Timeout: time.Minute,
+		Params:  map[string]string{"steps": "6"},
+	}
+	if !reflect.DeepEqual(s.Days[2023][21], expected) {
+		t.Errorf("expected %+v, but got %+v instead", expected, s.Days[2023][21])
+	}
+
+	var sb strings.Builder
+	if err := config.Print(&sb, s); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(sb.String(), "days.2023.21.params.steps") {
+		t.Errorf("expected the parameters of the days to be printed, but got\n%s", sb.String())
+	}
+}
+
+func TestResolveInvalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []string{
+		`output = "xml"`,
+		`timeout = "soon"`,
+		`jobs = -1`,
+		`[days.2023.26]`,
+		`[days.2023.1]
+timeout = "-1s"`,
+	}
+	for _, tt := range tests {
+		if _, err := config.Resolve(load(t, tt), nil, func(string) string { return "" }); !errors.Is(err, config.ErrInvalidConfig) {
+			t.Errorf("expected ErrInvalidConfig for %q, but got %v instead", tt, err)
+		}
+	}
+}
@@ -1,63 +1,264 @@
 package internal
 
 import (
-	"github.com/wlchs/advent_of_code_go_template/days/day_01"
-	"github.com/wlchs/advent_of_code_go_template/days/day_02"
-	"github.com/wlchs/advent_of_code_go_template/days/day_03"
-	"github.com/wlchs/advent_of_code_go_template/days/day_04"
-	"github.com/wlchs/advent_of_code_go_template/days/day_05"
-	"github.com/wlchs/advent_of_code_go_template/days/day_06"
-	"github.com/wlchs/advent_of_code_go_template/days/day_07"
-	"github.com/wlchs/advent_of_code_go_template/days/day_08"
-	"github.com/wlchs/advent_of_code_go_template/days/day_09"
-	"github.com/wlchs/advent_of_code_go_template/days/day_10"
-	"github.com/wlchs/advent_of_code_go_template/days/day_11"
-	"github.com/wlchs/advent_of_code_go_template/days/day_12"
-	"github.com/wlchs/advent_of_code_go_template/days/day_13"
-	"github.com/wlchs/advent_of_code_go_template/days/day_14"
-	"github.com/wlchs/advent_of_code_go_template/days/day_15"
-	"github.com/wlchs/advent_of_code_go_template/days/day_16"
-	"github.com/wlchs/advent_of_code_go_template/days/day_17"
-	"github.com/wlchs/advent_of_code_go_template/days/day_18"
-	"github.com/wlchs/advent_of_code_go_template/days/day_19"
-	"github.com/wlchs/advent_of_code_go_template/days/day_20"
-	"github.com/wlchs/advent_of_code_go_template/days/day_21"
-	"github.com/wlchs/advent_of_code_go_template/days/day_22"
-	"github.com/wlchs/advent_of_code_go_template/days/day_23"
-	"github.com/wlchs/advent_of_code_go_template/days/day_24"
-	"github.com/wlchs/advent_of_code_go_template/days/day_25"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/wlchs/advent_of_code_go_template/internal/registry"
+	"slices"
+	"sync"
+	"time"
 )
 
-// RunChallenge executes the challenge of a specific day with the provided input.
-func RunChallenge(day int, inputPath string, mode int) {
-	input := LoadInputLines(inputPath)
-	mapping := map[int]func([]string, int){
-		1:  day_01.Run,
-		2:  day_02.Run,
-		3:  day_03.Run,
-		4:  day_04.Run,
-		5:  day_05.Run,
-		6:  day_06.Run,
-		7:  day_07.Run,
-		8:  day_08.Run,
-		9:  day_09.Run,
-		10: day_10.Run,
-		11: day_11.Run,
-		12: day_12.Run,
-		13: day_13.Run,
-		14: day_14.Run,
-		15: day_15.Run,
-		16: day_16.Run,
-		17: day_17.Run,
-		18: day_18.Run,
-		19: day_19.Run,
-		20: day_20.Run,
-		21: day_21.Run,
-		22: day_22.Run,
-		23: day_23.Run,
-		24: day_24.Run,
-		25: day_25.Run,
-	}
-
-	mapping[day](input, mode)
+// Result of a single part of a daily challenge
+type Result struct {
+	Year        int
+	Day         int
+	Part        int
+	Answer      string
+	Duration    time.Duration
+	InputPath   string
+	InputSHA256 string
+	// Verification is the outcome of comparing the answer with the recorded one, empty if not verified
+	Verification string
+	// NotApplicable is set for selected parts the day doesn't have, such as the second part of the last day
+	NotApplicable bool
+	// Cached is set if the answer comes from the answer cache instead of running the solver
+	Cached bool
+	Err    error
+}
+
+// Status describes the outcome of the run in a short human-readable form.
+func (r Result) Status() string {
+	if r.Err != nil {
+		return r.Err.Error()
+	}
+	if r.NotApplicable {
+		return NotApplicable
+	}
+	status := "ok"
+	if r.Verification != "" {
+		status = r.Verification
+	}
+	if r.Cached {
+		status += " (cached)"
+	}
+	return status
+}
+
+// NotApplicable is the status of the selected parts a day doesn't have
+const NotApplicable = "not applicable"
+
+// ErrTimeout is recorded in the results of parts that didn't finish within the timeout.
+var ErrTimeout = errors.New("timed out")
+
+// ErrCancelled is recorded in the results of parts that were interrupted before finishing.
+var ErrCancelled = errors.New("cancelled")
+
+// RunOptions control how the challenges are executed
+type RunOptions struct {
+	// Parts selects the parts to run, both parts run if it's empty
+	Parts []int
+	// Timeout limits the run time of each part, 0 means no limit
+	Timeout time.Duration
+	// Jobs is the number of days solved concurrently, values below 2 run the days one after the other
+	Jobs int
+	// Profile selects the profiles recorded while solving each part, they require the days to run one after the other
+	Profile Profile
+	// Days override the options of specific days of the year
+	Days map[int]DayOptions
+	// Cache provides the answers of the parts solved by the same build before, and records the new ones if set
+	Cache *AnswerCache
+}
+
+// DayOptions override the run options of a specific day
+type DayOptions struct {
+	// Input replaces the input looked up in the inputs directory
+	Input string
+	// Timeout replaces the timeout of the run if set
+	Timeout time.Duration
+	// Params are passed to the solver through the context, see registry.Param
+	Params map[string]string
+}
+
+// forDay applies the overrides of the day to the options and the context
+func (o RunOptions) forDay(ctx context.Context, day int) (context.Context, RunOptions) {
+	d, ok := o.Days[day]
+	if !ok {
+		return ctx, o
+	}
+	if d.Timeout > 0 {
+		o.Timeout = d.Timeout
+	}
+	return registry.WithParams(ctx, d.Params), o
+}
+
+// selected tells whether the part is selected by the options
+func (o RunOptions) selected(part int) bool {
+	return len(o.Parts) == 0 || slices.Contains(o.Parts, part)
+}
+
+// RunChallenge executes the challenge of a specific year and day with the provided input.
+// Errors, such as an unknown day or a panicking solver, are recorded in the results.
+func RunChallenge(ctx context.Context, year int, day int, inputPath string, opts RunOptions) []Result {
+	return runDay(ctx, year, day, inputPath, opts)
+}
+
+// RunChallenges executes the challenges of the given days of a year on a pool of opts.Jobs workers.
+// The results are given back in the order of the days, regardless of which day finished first.
+// The inputs are selected by ResolveInput.
+// A failing day doesn't abort the run, its error is recorded in the results instead.
+func RunChallenges(ctx context.Context, year int, days []int, inputDir string, opts RunOptions) []Result {
+	perDay := make([][]Result, len(days))
+	queue := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < max(1, min(opts.Jobs, len(days))); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range queue {
+				inputPath, _ := ResolveInput(inputDir, year, days[i], opts.Days)
+				perDay[i] = runDay(ctx, year, days[i], inputPath, opts)
+			}
+		}()
+	}
+	for i := range days {
+		queue <- i
+	}
+	close(queue)
+	wg.Wait()
+
+	var results []Result
+	for _, r := range perDay {
+		results = append(results, r...)
+	}
+	return results
+}
+
+// runDay executes the selected parts of a single day's challenge and collects the results
+func runDay(ctx context.Context, year int, day int, inputPath string, opts RunOptions) []Result {
+	solver, err := registry.Lookup(year, day)
+	if err != nil {
+		return []Result{{Year: year, Day: day, InputPath: inputPath, Err: err}}
+	}
+
+	input, err := LoadInput(inputPath)
+	if err != nil {
+		return []Result{{Year: year, Day: day, InputPath: inputPath, Err: err}}
+	}
+	ctx, opts = opts.forDay(ctx, day)
+	return solveInput(ctx, solver, input, opts)
+}
+
+// SolveInput executes the challenge of a specific year and day with an input that is already loaded.
+func SolveInput(ctx context.Context, year int, day int, input Input, opts RunOptions) []Result {
+	solver, err := registry.Lookup(year, day)
+	if err != nil {
+		return []Result{{Year: year, Day: day, InputPath: input.Path, Err: err}}
+	}
+	ctx, opts = opts.forDay(ctx, day)
+	return solveInput(ctx, solver, input, opts)
+}
+
+// solveInput executes the selected parts of the solver with the input and collects the results.
+// Selected parts the solver doesn't have are reported as not applicable.
+func solveInput(ctx context.Context, solver registry.Solver, input Input, opts RunOptions) []Result {
+	var results []Result
+	for _, part := range []int{1, 2} {
+		if !opts.selected(part) {
+			continue
+		}
+		res := Result{Year: solver.Year(), Day: solver.Day(), Part: part, NotApplicable: true}
+		if slices.Contains(registry.Parts(solver), part) {
+			res = solveCached(ctx, solver, part, input, opts)
+		}
+		res.InputPath = input.Path
+		res.InputSHA256 = input.SHA256
+		results = append(results, res)
+	}
+	return results
+}
+
+// solveCached gives back the cached answer of the part if there is one, otherwise it solves the part and caches the
+// answer if it succeeded. The answers are cached separately for each set of parameters of the day.
+func solveCached(ctx context.Context, solver registry.Solver, part int, input Input, opts RunOptions) Result {
+	if opts.Cache == nil {
+		return solveProfiled(ctx, solver, part, input.Lines, opts)
+	}
+	params := opts.Days[solver.Day()].Params
+	if answer, ok := opts.Cache.Lookup(solver.Year(), solver.Day(), part, input.SHA256, params); ok {
+		return Result{Year: solver.Year(), Day: solver.Day(), Part: part, Answer: answer, Cached: true}
+	}
+
+	res := solveProfiled(ctx, solver, part, input.Lines, opts)
+	if res.Err == nil {
+		opts.Cache.Record(solver.Year(), solver.Day(), part, input.SHA256, params, res.Answer)
+	}
+	return res
+}
+
+// solveProfiled solves one part of the challenge while recording the profiles selected in the options
+func solveProfiled(ctx context.Context, solver registry.Solver, part int, input []string, opts RunOptions) Result {
+	if !opts.Profile.Enabled() {
+		return solvePart(ctx, solver, part, input, opts.Timeout)
+	}
+
+	stop, err := opts.Profile.start(solver.Year(), solver.Day(), part)
+	if err != nil {
+		return Result{Year: solver.Year(), Day: solver.Day(), Part: part, Err: fmt.Errorf("failed to start profiling: %w", err)}
+	}
+	res := solvePart(ctx, solver, part, input, opts.Timeout)
+	if err := stop(); err != nil && res.Err == nil {
+		res.Err = fmt.Errorf("failed to write profiles: %w", err)
+	}
+	return res
+}
+
+// solvePart runs and times one part of the challenge, recovering from panics in the solver.
+// The part is abandoned once the timeout expires or the context is cancelled, even if the solver doesn't check
+// the context; its goroutine then keeps running in the background until it returns.
+func solvePart(ctx context.Context, solver registry.Solver, part int, input []string, timeout time.Duration) Result {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	res := Result{Year: solver.Year(), Day: solver.Day(), Part: part}
+	done := make(chan Result, 1)
+	start := time.Now()
+	go func() {
+		var r Result
+		defer func() {
+			if p := recover(); p != nil {
+				r.Err = fmt.Errorf("panic: %v", p)
+			}
+			done <- r
+		}()
+		r.Answer, r.Err = registry.Solve(ctx, solver, part, input)
+	}()
+
+	select {
+	case r := <-done:
+		res.Answer, res.Err = r.Answer, r.Err
+	case <-ctx.Done():
+		res.Err = ctx.Err()
+	}
+	res.Duration = time.Since(start)
+
+	switch {
+	case errors.Is(res.Err, context.DeadlineExceeded):
+		res.Answer, res.Err = "", fmt.Errorf("%w after %v", ErrTimeout, round(res.Duration))
+	case errors.Is(res.Err, context.Canceled):
+		res.Answer, res.Err = "", ErrCancelled
+	}
+	return res
+}
+
+// PartLabel formats the part number for display, results not belonging to any part are shown as "-"
+func PartLabel(part int) string {
+	if part == 0 {
+		return "-"
+	}
+	return fmt.Sprint(part)
 }
@@ -0,0 +1,130 @@
+package internal_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/wlchs/advent_of_code_go_template/internal"
+	"github.com/wlchs/advent_of_code_go_template/internal/registry"
+	_ "github.com/wlchs/advent_of_code_go_template/years"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestRunChallenges(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "2023"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	// the second example of day 1 has no digits in one of its lines, so the first part panics
+	example, err := os.ReadFile("../years/2023/day_01/input_2_test.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(internal.InputPath(dir, 2023, 1), example, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	results := internal.RunChallenges(context.Background(), 2023, []int{1, 2}, dir, internal.RunOptions{})
+	if len(results) != 3 {
+		t.Fatalf("expected 3 results, but got %d instead", len(results))
+	}
+	if results[0].Err == nil {
+		t.Error("expected the panic of part one to be recorded")
+	}
+	if results[1].Answer != "281" || results[1].Err != nil {
+		t.Errorf("expected part two to still run, but got %s, %v instead", results[1].Answer, results[1].Err)
+	}
+	if results[2].Day != 2 || results[2].Err == nil {
+		t.Errorf("expected missing input error for day 2, but got %+v instead", results[2])
+	}
+}
+
+// blockingSolver waits for the cancellation of the context in the first part and ignores it in the second one
+var blockingSolver = registry.Register(registry.NewContextSolver(1, 1, "Blocking",
+	func(ctx context.Context, _ []string) (string, error) {
+		<-ctx.Done()
+		return "", ctx.Err()
+	},
+	func(_ context.Context, _ []string) (string, error) {
+		time.Sleep(time.Second)
+		return "late", nil
+	},
+))
+
+func TestRunChallengeTimeout(t *testing.T) {
+	t.Parallel()
+
+	opts := internal.RunOptions{Timeout: 10 * time.Millisecond}
+	results := internal.RunChallenge(context.Background(), blockingSolver.Year(), blockingSolver.Day(), "../years/2023/day_01/input_1_test.txt", opts)
+	if len(results) != 2 {
+		t.Fatalf("expected 2 results, but got %d instead", len(results))
+	}
+	for _, r := range results {
+		if !errors.Is(r.Err, internal.ErrTimeout) || r.Answer != "" {
+			t.Errorf("expected part %d to time out, but got %q, %v instead", r.Part, r.Answer, r.Err)
+		}
+		if r.Duration >= time.Second {
+			t.Errorf("expected part %d to be abandoned at the timeout, but it took %v", r.Part, r.Duration)
+		}
+	}
+}
+
+func TestRunChallengeCancelled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	results := internal.RunChallenge(ctx, 2023, 1, "../years/2023/day_01/input_1_test.txt", internal.RunOptions{Parts: []int{1}})
+	if len(results) != 1 || !errors.Is(results[0].Err, internal.ErrCancelled) {
+		t.Errorf("expected a cancelled result, but got %+v instead", results)
+	}
+}
+
+func TestRunChallengeNotApplicable(t *testing.T) {
+	t.Parallel()
+
+	results := internal.RunChallenge(context.Background(), 2023, 25, "../years/2023/day_25/input_1_test.txt", internal.RunOptions{})
+	if len(results) != 2 || results[0].Answer != "54" {
+		t.Fatalf("expected the answer of part one and a second result, but got %+v instead", results)
+	}
+	if r := results[1]; !r.NotApplicable || r.Err != nil || r.Status() != internal.NotApplicable {
+		t.Errorf("expected part two to be not applicable, but got %+v instead", r)
+	}
+}
+
+func TestRunChallengesJobs(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "2023"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	var days []int
+	for day := 1; day <= 25; day++ {
+		example, err := os.ReadFile(fmt.Sprintf("../years/2023/day_%02d/input_1_test.txt", day))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(internal.InputPath(dir, 2023, day), example, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		days = append(days, day)
+	}
+
+	sequential := internal.RunChallenges(context.Background(), 2023, days, dir, internal.RunOptions{Parts: []int{1}})
+	parallel := internal.RunChallenges(context.Background(), 2023, days, dir, internal.RunOptions{Parts: []int{1}, Jobs: 8})
+	if len(parallel) != len(sequential) {
+		t.Fatalf("expected %d results, but got %d instead", len(sequential), len(parallel))
+	}
+	for i := range sequential {
+		s, p := sequential[i], parallel[i]
+		if s.Day != p.Day || s.Part != p.Part || s.Answer != p.Answer || fmt.Sprint(s.Err) != fmt.Sprint(p.Err) {
+			t.Errorf("expected %d/%d %q %v, but got %d/%d %q %v instead", s.Day, s.Part, s.Answer, s.Err, p.Day, p.Part, p.Answer, p.Err)
+		}
+	}
+}
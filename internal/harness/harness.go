@@ -0,0 +1,205 @@
+package harness
+
+import (
+	"context"
+	"fmt"
+	"github.com/wlchs/advent_of_code_go_template/internal"
+	"github.com/wlchs/advent_of_code_go_template/internal/registry"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// examplePattern matches the example input files, e.g. input_1_test.txt or input_1b_test.txt
+var examplePattern = regexp.MustCompile(`^input_(\d+)([a-z]*)_test\.txt$`)
+
+// Example is a pair of an example input and its expected solution
+type Example struct {
+	Part         int
+	Name         string
+	InputPath    string
+	SolutionPath string
+}
+
+// Examples discovers the example inputs in the directory along with their solution files.
+// The example input_1b_test.txt of the first part is solved by solution_1b.txt, for instance.
+func Examples(dir string) ([]Example, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var examples []Example
+	for _, e := range entries {
+		match := examplePattern.FindStringSubmatch(e.Name())
+		if e.IsDir() || match == nil {
+			continue
+		}
+		part, _ := strconv.Atoi(match[1])
+		examples = append(examples, Example{
+			Part:         part,
+			Name:         "part_" + match[1] + match[2],
+			InputPath:    filepath.Join(dir, e.Name()),
+			SolutionPath: filepath.Join(dir, fmt.Sprintf("solution_%s%s.txt", match[1], match[2])),
+		})
+	}
+
+	sort.Slice(examples, func(i, j int) bool {
+		return examples[i].Name < examples[j].Name
+	})
+	return examples, nil
+}
+
+// Test runs the solver with every example of the directory as parallel subtests.
+// Examples having neither an input nor a solution, such as the ones of a freshly generated day, are skipped.
+func Test(t *testing.T, s registry.Solver, dir string) {
+	t.Helper()
+
+	examples, err := Examples(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(examples) == 0 {
+		t.Fatalf("no examples found in %s", dir)
+	}
+
+	for _, e := range examples {
+		e := e
+		t.Run(e.Name, func(t *testing.T) {
+			t.Parallel()
+
+			input, expected := load(t, e)
+			if len(input) == 0 && expected == "" {
+				t.Skip("example not provided yet")
+			}
+
+			result, err := registry.Solve(context.Background(), s, e.Part, input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if result != expected {
+				t.Errorf("unexpected result for %s\n%s", e.InputPath, Diff(expected, result))
+			}
+		})
+	}
+}
+
+// Concurrent runs the solver with every example of the directory at the same time, each of them the given number of
+// times, to reveal state shared between the runs. Run it with the race detector to catch data races as well.
+func Concurrent(t *testing.T, s registry.Solver, dir string, runs int) {
+	t.Helper()
+
+	examples, err := Examples(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	for _, e := range examples {
+		input, expected := load(t, e)
+		if len(input) == 0 && expected == "" {
+			continue
+		}
+		for i := 0; i < runs; i++ {
+			wg.Add(1)
+			go func(e Example) {
+				defer wg.Done()
+				result, err := registry.Solve(context.Background(), s, e.Part, input)
+				if err != nil {
+					t.Errorf("%s: %v", e.InputPath, err)
+				} else if result != expected {
+					t.Errorf("unexpected concurrent result for %s\n%s", e.InputPath, Diff(expected, result))
+				}
+			}(e)
+		}
+	}
+	wg.Wait()
+}
+
+// Benchmark runs every example of the directory as sub-benchmarks.
+func Benchmark(b *testing.B, s registry.Solver, dir string) {
+	b.Helper()
+
+	examples, err := Examples(dir)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	for _, e := range examples {
+		e := e
+		b.Run(e.Name, func(b *testing.B) {
+			input, _ := load(b, e)
+			if len(input) == 0 {
+				b.Skip("example not provided yet")
+			}
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := registry.Solve(context.Background(), s, e.Part, input); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkAll benchmarks every registered solver with its examples.
+// The root is the directory containing the years, such as years/2023/day_05 for the project root.
+func BenchmarkAll(b *testing.B, root string) {
+	b.Helper()
+
+	for _, s := range registry.All() {
+		s := s
+		dir := filepath.Join(root, fmt.Sprint(s.Year()), fmt.Sprintf("day_%02d", s.Day()))
+		b.Run(fmt.Sprintf("%d/day_%02d", s.Year(), s.Day()), func(b *testing.B) {
+			Benchmark(b, s, dir)
+		})
+	}
+}
+
+// Diff describes the differences between the expected and the actual result line by line.
+func Diff(expected string, actual string) string {
+	exp := strings.Split(expected, "\n")
+	act := strings.Split(actual, "\n")
+
+	var sb strings.Builder
+	sb.WriteString("--- expected\n+++ actual\n")
+	for i := 0; i < max(len(exp), len(act)); i++ {
+		e, a := line(exp, i), line(act, i)
+		if e == a {
+			sb.WriteString(fmt.Sprintf("  %3d   %s\n", i+1, e))
+			continue
+		}
+		sb.WriteString(fmt.Sprintf("- %3d   %s\n", i+1, e))
+		sb.WriteString(fmt.Sprintf("+ %3d   %s\n", i+1, a))
+	}
+	return sb.String()
+}
+
+// line gives back the line with the given index, or an empty string if there are fewer lines
+func line(lines []string, i int) string {
+	if i < len(lines) {
+		return lines[i]
+	}
+	return ""
+}
+
+// load reads the input and the expected solution of the example
+func load(tb testing.TB, e Example) ([]string, string) {
+	tb.Helper()
+
+	input, err := internal.LoadInputLines(e.InputPath)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	solution, err := internal.LoadInputLines(e.SolutionPath)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	return input, strings.Join(solution, "\n")
+}
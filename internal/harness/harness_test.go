@@ -0,0 +1,52 @@
+package harness_test
+
+import (
+	"github.com/wlchs/advent_of_code_go_template/internal/harness"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestExamples(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	for _, name := range []string{"input_1_test.txt", "input_1b_test.txt", "input_2_test.txt", "solution_1.txt", "notes.txt"} {
+		if err := os.WriteFile(filepath.Join(dir, name), nil, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	examples, err := harness.Examples(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []harness.Example{
+		{Part: 1, Name: "part_1", InputPath: filepath.Join(dir, "input_1_test.txt"), SolutionPath: filepath.Join(dir, "solution_1.txt")},
+		{Part: 1, Name: "part_1b", InputPath: filepath.Join(dir, "input_1b_test.txt"), SolutionPath: filepath.Join(dir, "solution_1b.txt")},
+		{Part: 2, Name: "part_2", InputPath: filepath.Join(dir, "input_2_test.txt"), SolutionPath: filepath.Join(dir, "solution_2.txt")},
+	}
+	if len(examples) != len(expected) {
+		t.Fatalf("expected %d examples, but got %v instead", len(expected), examples)
+	}
+	for i := range expected {
+		if examples[i] != expected[i] {
+			t.Errorf("expected %+v, but got %+v instead", expected[i], examples[i])
+		}
+	}
+}
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	expected := "--- expected\n+++ actual\n" +
+		"    1   same\n" +
+		"-   2   old\n" +
+		"+   2   new\n" +
+		"-   3   \n" +
+		"+   3   extra\n"
+	if diff := harness.Diff("same\nold", "same\nnew\nextra"); diff != expected {
+		t.Errorf("expected diff\n%s\nbut got\n%s\ninstead", expected, diff)
+	}
+}
@@ -0,0 +1,142 @@
+package internal
+
+import (
+	"fmt"
+	"github.com/wlchs/advent_of_code_go_template/internal/registry"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+)
+
+// InputsDirEnv is the environment variable that overrides the default inputs directory.
+const InputsDirEnv = "AOC_INPUTS_DIR"
+
+// defaultInputsDir is the inputs directory used if nothing else is configured
+const defaultInputsDir = "inputs"
+
+// inputExtensions lists the accepted input file extensions in order of preference
+var inputExtensions = []string{".txt", ".txt.gz", ".txt.zst"}
+
+// ResolveInputsDir selects the inputs directory.
+// The explicitly provided directory takes precedence over the AOC_INPUTS_DIR environment variable,
+// which in turn overrides the default inputs directory.
+func ResolveInputsDir(dir string) string {
+	if dir != "" {
+		return dir
+	}
+	if env := os.Getenv(InputsDirEnv); env != "" {
+		return env
+	}
+	return defaultInputsDir
+}
+
+// InputPath builds the conventional path of a day's input file inside the inputs directory,
+// e.g. inputs/2023/day_05.txt.
+func InputPath(inputDir string, year int, day int) string {
+	return filepath.Join(inputDir, fmt.Sprint(year), fmt.Sprintf("day_%02d.txt", day))
+}
+
+// FindInput looks for the input file of a day in the inputs directory.
+// Besides plain text files, compressed inputs such as inputs/2023/day_05.txt.gz are also accepted.
+// If no input exists, the conventional plain text path is returned with false.
+func FindInput(inputDir string, year int, day int) (string, bool) {
+	base := strings.TrimSuffix(InputPath(inputDir, year, day), ".txt")
+	for _, ext := range inputExtensions {
+		if _, err := os.Stat(base + ext); err == nil {
+			return base + ext, true
+		}
+	}
+	return base + ".txt", false
+}
+
+// ResolveInput selects the input file of a day: the input set in the day's options takes precedence over the one
+// found in the inputs directory by FindInput. The returned flag reports whether the file exists.
+// Every subcommand working with the input of a day resolves it here, so that they all use the same file.
+func ResolveInput(inputDir string, year int, day int, days map[int]DayOptions) (string, bool) {
+	if d := days[day]; d.Input != "" {
+		return d.Input, exists(d.Input)
+	}
+	return FindInput(inputDir, year, day)
+}
+
+// DayDir gives back the directory of a day's package relative to the project root, e.g. years/2023/day_05.
+func DayDir(year int, day int) string {
+	return filepath.Join("years", fmt.Sprint(year), fmt.Sprintf("day_%02d", day))
+}
+
+// InputInfo summarizes the available inputs of a daily challenge
+type InputInfo struct {
+	Year     int
+	Day      int
+	Title    string
+	Input    string
+	HasInput bool
+	Parts    []int
+	Examples []int
+	Answers  []int
+	Recorded []int
+}
+
+// ListInputs collects the inputs of every registered day along with the parts having a recorded answer.
+// The inputs are selected by ResolveInput with the overrides of the days keyed by year and day.
+// Example inputs and their answers are looked up in the day's package directory,
+// so the project root is expected to be the working directory.
+func ListInputs(inputDir string, days map[int]map[int]DayOptions, store *AnswerStore) []InputInfo {
+	var res []InputInfo
+	for _, s := range registry.All() {
+		info := InputInfo{Year: s.Year(), Day: s.Day(), Title: s.Title(), Parts: registry.Parts(s)}
+		info.Input, info.HasInput = ResolveInput(inputDir, s.Year(), s.Day(), days[s.Year()])
+		var input Input
+		if info.HasInput {
+			input, _ = LoadInput(info.Input)
+		}
+		for _, part := range info.Parts {
+			if _, ok := store.Lookup(s.Year(), s.Day(), part, input.SHA256); ok && input.SHA256 != "" {
+				info.Recorded = append(info.Recorded, part)
+			}
+			if exists(filepath.Join(DayDir(s.Year(), s.Day()), fmt.Sprintf("input_%d_test.txt", part))) {
+				info.Examples = append(info.Examples, part)
+			}
+			if exists(filepath.Join(DayDir(s.Year(), s.Day()), fmt.Sprintf("solution_%d.txt", part))) {
+				info.Answers = append(info.Answers, part)
+			}
+		}
+		res = append(res, info)
+	}
+	return res
+}
+
+// PrintInputs writes the input summary as a table.
+func PrintInputs(w io.Writer, infos []InputInfo) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "YEAR\tDAY\tTITLE\tINPUT\tRECORDED\tEXAMPLES\tEXAMPLE ANSWERS")
+	for _, i := range infos {
+		input := "-"
+		if i.HasInput {
+			input = i.Input
+		}
+		_, _ = fmt.Fprintf(tw, "%d\t%d\t%s\t%s\t%s\t%s\t%s\n",
+			i.Year, i.Day, i.Title, input, partList(i.Recorded), partList(i.Examples), partList(i.Answers))
+	}
+	return tw.Flush()
+}
+
+// exists checks whether the file exists
+func exists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+// partList formats a list of parts for display, e.g. "1,2"
+func partList(parts []int) string {
+	if len(parts) == 0 {
+		return "-"
+	}
+	s := make([]string, 0, len(parts))
+	for _, p := range parts {
+		s = append(s, fmt.Sprint(p))
+	}
+	return strings.Join(s, ",")
+}
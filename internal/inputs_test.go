@@ -0,0 +1,83 @@
+package internal_test
+
+import (
+	"context"
+	"github.com/wlchs/advent_of_code_go_template/internal"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestResolveInputsDir(t *testing.T) {
+	t.Setenv(internal.InputsDirEnv, "")
+	if dir := internal.ResolveInputsDir(""); dir != "inputs" {
+		t.Errorf("expected default inputs directory, but got %s instead", dir)
+	}
+
+	t.Setenv(internal.InputsDirEnv, "from_env")
+	if dir := internal.ResolveInputsDir(""); dir != "from_env" {
+		t.Errorf("expected inputs directory from the environment, but got %s instead", dir)
+	}
+	if dir := internal.ResolveInputsDir("from_flag"); dir != "from_flag" {
+		t.Errorf("expected explicit inputs directory, but got %s instead", dir)
+	}
+}
+
+func TestFindInput(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	expected := filepath.Join(dir, "2023", "day_05.txt")
+	if path, ok := internal.FindInput(dir, 2023, 5); ok || path != expected {
+		t.Errorf("expected missing %s, but got %s, %v instead", expected, path, ok)
+	}
+
+	if err := os.MkdirAll(filepath.Join(dir, "2023"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(expected+".gz", nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if path, ok := internal.FindInput(dir, 2023, 5); !ok || path != expected+".gz" {
+		t.Errorf("expected compressed input, but got %s, %v instead", path, ok)
+	}
+}
+
+func TestResolveInput(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	override := filepath.Join(t.TempDir(), "example.txt")
+	example, err := os.ReadFile("../years/2023/day_01/input_1_test.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(override, example, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	days := map[int]internal.DayOptions{1: {Input: override}}
+
+	if path, ok := internal.ResolveInput(dir, 2023, 1, days); !ok || path != override {
+		t.Errorf("expected the overridden input %s, but got %s, %v instead", override, path, ok)
+	}
+	if path, ok := internal.ResolveInput(dir, 2023, 2, days); ok || path != internal.InputPath(dir, 2023, 2) {
+		t.Errorf("expected the missing input of the inputs directory, but got %s, %v instead", path, ok)
+	}
+
+	// submit solves a single part of the day the same way, so it must not fall back to the inputs directory
+	opts := internal.RunOptions{Parts: []int{1}, Days: days}
+	results := internal.RunChallenges(context.Background(), 2023, []int{1}, dir, opts)
+	if len(results) != 1 || results[0].Answer != "142" || results[0].InputPath != override {
+		t.Errorf("expected the answer of the overridden input, but got %+v instead", results)
+	}
+
+	store, err := internal.LoadAnswers(internal.AnswersPath(dir))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, info := range internal.ListInputs(dir, map[int]map[int]internal.DayOptions{2023: days}, store) {
+		if info.Year == 2023 && info.Day == 1 && (!info.HasInput || info.Input != override) {
+			t.Errorf("expected the overridden input to be listed, but got %+v instead", info)
+		}
+	}
+}
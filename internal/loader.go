@@ -1,58 +1,133 @@
 package internal
 
 import (
-	"bufio"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"github.com/klauspost/compress/zstd"
+	"io"
 	"os"
+	"strings"
 )
 
-// LoadInputLines loads a text file from the given path as a string slice.
-func LoadInputLines(path string) []string {
-	if path == "" {
-		fmt.Println("missing input path")
-		os.Exit(1)
-	}
+// StdinPath is the input path that makes the loader read the standard input.
+const StdinPath = "-"
 
-	file, err := os.Open(path)
-	defer func(file *os.File) {
-		err := file.Close()
-		if err != nil {
-			fmt.Printf("failed to close file \"%s\"\n", path)
-		}
-	}(file)
+// ErrMissingInputPath is returned when no input path is provided.
+var ErrMissingInputPath = errors.New("missing input path")
+
+// InputError describes a failure while loading an input file.
+type InputError struct {
+	Path string
+	Err  error
+}
+
+// Error implements the error interface.
+func (e *InputError) Error() string {
+	return fmt.Sprintf("failed to load input from \"%s\": %v", e.Path, e.Err)
+}
 
+// Unwrap gives back the underlying error.
+func (e *InputError) Unwrap() error {
+	return e.Err
+}
+
+// Input is the content of an input file prepared for the solvers
+type Input struct {
+	Path   string
+	Lines  []string
+	SHA256 string
+}
+
+// LoadInput loads an input file from the given path.
+// The "-" path reads the standard input. Files ending with .gz or .zst are decompressed transparently.
+// The checksum is calculated over the decompressed content.
+func LoadInput(path string) (Input, error) {
+	data, err := readInput(path)
 	if err != nil {
-		fmt.Printf("failed to load input from \"%s\"\n", path)
+		return Input{}, &InputError{Path: path, Err: err}
 	}
+	return NewInput(path, data), nil
+}
 
-	var lines []string
-	scanner := bufio.NewScanner(file)
-	for scanner.Scan() {
-		lines = append(lines, scanner.Text())
-	}
+// NewInput prepares the already read and decompressed content of an input for the solvers.
+func NewInput(path string, data []byte) Input {
+	sum := sha256.Sum256(data)
+	return Input{Path: path, Lines: ParseInputLines(data), SHA256: hex.EncodeToString(sum[:])}
+}
 
-	if err := scanner.Err(); err != nil {
-		fmt.Printf("failed to read file, %s\n", err)
+// LoadInputLines loads a text file from the given path as a string slice.
+func LoadInputLines(path string) ([]string, error) {
+	input, err := LoadInput(path)
+	return input.Lines, err
+}
+
+// LoadFirstInputLine loads the first line of a text file from the given path as a string.
+func LoadFirstInputLine(path string) (string, error) {
+	lines, err := LoadInputLines(path)
+	if err != nil || len(lines) == 0 {
+		return "", err
 	}
+	return lines[0], nil
+}
 
-	return lines
+// ParseInputLines splits the content of an input file into lines.
+// Windows line endings are normalised, and trailing empty lines are dropped.
+func ParseInputLines(data []byte) []string {
+	s := strings.ReplaceAll(string(data), "\r\n", "\n")
+	s = strings.TrimRight(s, "\n")
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, "\n")
 }
 
-// LoadFirstInputLine loads the first line of a text file from the given path as a string.
-func LoadFirstInputLine(path string) string {
-	file, err := os.Open(path)
-	defer func(file *os.File) {
-		err := file.Close()
+// readInput reads and decompresses the content of the input file
+func readInput(path string) ([]byte, error) {
+	if path == "" {
+		return nil, ErrMissingInputPath
+	}
+
+	var r io.Reader = os.Stdin
+	if path != StdinPath {
+		file, err := os.Open(path)
 		if err != nil {
-			fmt.Printf("failed to close file \"%s\"\n", path)
+			return nil, err
 		}
-	}(file)
+		defer func(file *os.File) {
+			_ = file.Close()
+		}(file)
+		r = file
+	}
 
+	data, err := io.ReadAll(r)
 	if err != nil {
-		fmt.Printf("failed to load input from \"%s\"\n", path)
+		return nil, err
 	}
+	return decompress(path, data)
+}
 
-	scanner := bufio.NewScanner(file)
-	scanner.Scan()
-	return scanner.Text()
+// decompress decodes the data based on the extension of the input path
+func decompress(path string, data []byte) ([]byte, error) {
+	switch {
+	case strings.HasSuffix(path, ".gz"):
+		r, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		defer r.Close()
+		return io.ReadAll(r)
+	case strings.HasSuffix(path, ".zst"):
+		d, err := zstd.NewReader(nil)
+		if err != nil {
+			return nil, err
+		}
+		defer d.Close()
+		return d.DecodeAll(data, nil)
+	default:
+		return data, nil
+	}
 }
@@ -0,0 +1,92 @@
+package internal_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"github.com/klauspost/compress/zstd"
+	"github.com/wlchs/advent_of_code_go_template/internal"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+const content = "first\r\nsecond\r\n\r\nfourth\r\n\r\n"
+
+var expectedLines = []string{"first", "second", "", "fourth"}
+
+func TestParseInputLines(t *testing.T) {
+	t.Parallel()
+
+	if lines := internal.ParseInputLines([]byte(content)); !slices.Equal(lines, expectedLines) {
+		t.Errorf("expected %q, but got %q instead", expectedLines, lines)
+	}
+	if lines := internal.ParseInputLines([]byte("\n")); len(lines) != 0 {
+		t.Errorf("expected no lines, but got %q instead", lines)
+	}
+}
+
+func TestLoadInputCompressed(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	var gz bytes.Buffer
+	w := gzip.NewWriter(&gz)
+	if _, err := w.Write([]byte(content)); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	enc, err := zstd.NewWriter(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	zst := enc.EncodeAll([]byte(content), nil)
+
+	files := map[string][]byte{"input.txt": []byte(content), "input.txt.gz": gz.Bytes(), "input.txt.zst": zst}
+	var checksums []string
+	for name, data := range files {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, data, 0o644); err != nil {
+			t.Fatal(err)
+		}
+
+		input, err := internal.LoadInput(path)
+		if err != nil {
+			t.Fatalf("unexpected error for %s: %v", name, err)
+		}
+		if !slices.Equal(input.Lines, expectedLines) {
+			t.Errorf("expected %q for %s, but got %q instead", expectedLines, name, input.Lines)
+		}
+		checksums = append(checksums, input.SHA256)
+	}
+
+	if checksums[0] != checksums[1] || checksums[1] != checksums[2] {
+		t.Errorf("expected identical checksums of the decompressed content, but got %v instead", checksums)
+	}
+}
+
+func TestLoadInputErrors(t *testing.T) {
+	t.Parallel()
+
+	var inputErr *internal.InputError
+	if _, err := internal.LoadInputLines("missing.txt"); !errors.As(err, &inputErr) || !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("expected missing file InputError, but got %v instead", err)
+	}
+	if _, err := internal.LoadInputLines(""); !errors.Is(err, internal.ErrMissingInputPath) {
+		t.Errorf("expected ErrMissingInputPath, but got %v instead", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "broken.gz")
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := internal.LoadInputLines(path); !errors.As(err, &inputErr) {
+		t.Errorf("expected InputError for corrupt archive, but got %v instead", err)
+	}
+}
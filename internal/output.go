@@ -0,0 +1,197 @@
+package internal
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"text/tabwriter"
+	"time"
+)
+
+// ErrUnknownFormat is returned when the requested output format is not supported.
+var ErrUnknownFormat = errors.New("unknown output format")
+
+// Format of the printed results
+type Format string
+
+// Supported output formats
+const (
+	FormatText Format = "text"
+	FormatJSON Format = "json"
+	FormatCSV  Format = "csv"
+	FormatTAP  Format = "tap"
+)
+
+// ParseFormat validates the name of an output format.
+func ParseFormat(s string) (Format, error) {
+	switch f := Format(s); f {
+	case FormatText, FormatJSON, FormatCSV, FormatTAP:
+		return f, nil
+	default:
+		return "", fmt.Errorf("%w %q, expected one of text, json, csv or tap", ErrUnknownFormat, s)
+	}
+}
+
+// record is the machine-readable representation of a Result
+type record struct {
+	Year         int    `json:"year"`
+	Day          int    `json:"day"`
+	Part         int    `json:"part,omitempty"`
+	Answer       string `json:"answer"`
+	DurationNs   int64  `json:"duration_ns"`
+	InputPath    string `json:"input"`
+	InputSHA256  string `json:"input_sha256"`
+	Status       string `json:"status"`
+	Verification string `json:"verification,omitempty"`
+	Cached       bool   `json:"cached,omitempty"`
+	Error        string `json:"error,omitempty"`
+}
+
+// newRecord converts the result to its machine-readable representation
+func newRecord(r Result) record {
+	rec := record{
+		Year:         r.Year,
+		Day:          r.Day,
+		Part:         r.Part,
+		Answer:       r.Answer,
+		DurationNs:   r.Duration.Nanoseconds(),
+		InputPath:    r.InputPath,
+		InputSHA256:  r.InputSHA256,
+		Status:       "ok",
+		Verification: r.Verification,
+		Cached:       r.Cached,
+	}
+	if r.NotApplicable {
+		rec.Status = NotApplicable
+	}
+	if r.Err != nil {
+		rec.Status = "error"
+		rec.Error = r.Err.Error()
+	}
+	return rec
+}
+
+// WriteResults writes the results in the given format.
+func WriteResults(w io.Writer, format Format, results []Result) error {
+	switch format {
+	case FormatJSON:
+		return writeJSON(w, results)
+	case FormatCSV:
+		return writeCSV(w, results)
+	case FormatTAP:
+		return writeTAP(w, results)
+	default:
+		return PrintSummary(w, results)
+	}
+}
+
+// PrintSummary writes the results as a table.
+func PrintSummary(w io.Writer, results []Result) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "DAY\tPART\tANSWER\tDURATION\tSTATUS")
+	for _, r := range results {
+		_, _ = fmt.Fprintf(tw, "%d\t%s\t%s\t%v\t%s\n", r.Day, PartLabel(r.Part), r.Answer, round(r.Duration), r.Status())
+	}
+	return tw.Flush()
+}
+
+// writeJSON writes the results as a JSON array
+func writeJSON(w io.Writer, results []Result) error {
+	records := make([]record, 0, len(results))
+	for _, r := range results {
+		records = append(records, newRecord(r))
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(records)
+}
+
+// ReadResults decodes the results written in the JSON format, e.g. by a child process.
+// The errors of the results are restored from their messages only.
+func ReadResults(r io.Reader) ([]Result, error) {
+	var records []record
+	if err := json.NewDecoder(r).Decode(&records); err != nil {
+		return nil, err
+	}
+
+	results := make([]Result, 0, len(records))
+	for _, rec := range records {
+		res := Result{
+			Year:          rec.Year,
+			Day:           rec.Day,
+			Part:          rec.Part,
+			Answer:        rec.Answer,
+			Duration:      time.Duration(rec.DurationNs),
+			InputPath:     rec.InputPath,
+			InputSHA256:   rec.InputSHA256,
+			Verification:  rec.Verification,
+			NotApplicable: rec.Status == NotApplicable,
+			Cached:        rec.Cached,
+		}
+		if rec.Error != "" {
+			res.Err = errors.New(rec.Error)
+		}
+		results = append(results, res)
+	}
+	return results, nil
+}
+
+// writeCSV writes the results as CSV with a header row
+func writeCSV(w io.Writer, results []Result) error {
+	cw := csv.NewWriter(w)
+	_ = cw.Write([]string{"year", "day", "part", "answer", "duration_ns", "input", "input_sha256", "status", "verification", "cached", "error"})
+	for _, r := range results {
+		rec := newRecord(r)
+		_ = cw.Write([]string{
+			strconv.Itoa(rec.Year),
+			strconv.Itoa(rec.Day),
+			strconv.Itoa(rec.Part),
+			rec.Answer,
+			strconv.FormatInt(rec.DurationNs, 10),
+			rec.InputPath,
+			rec.InputSHA256,
+			rec.Status,
+			rec.Verification,
+			strconv.FormatBool(rec.Cached),
+			rec.Error,
+		})
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// writeTAP writes the results in the Test Anything Protocol format, one test point per result
+func writeTAP(w io.Writer, results []Result) error {
+	if _, err := fmt.Fprintf(w, "TAP version 13\n1..%d\n", len(results)); err != nil {
+		return err
+	}
+	for i, r := range results {
+		description := fmt.Sprintf("%d day %d part %s", r.Year, r.Day, PartLabel(r.Part))
+		if r.Err != nil {
+			_, _ = fmt.Fprintf(w, "not ok %d - %s\n  ---\n  message: %q\n  input: %q\n  ...\n", i+1, description, r.Err.Error(), r.InputPath)
+			continue
+		}
+		if r.NotApplicable {
+			_, _ = fmt.Fprintf(w, "ok %d - %s # SKIP %s\n", i+1, description, NotApplicable)
+			continue
+		}
+		_, _ = fmt.Fprintf(w, "ok %d - %s: %s\n  ---\n  duration_ns: %d\n  input: %q\n  input_sha256: %s\n",
+			i+1, description, r.Answer, r.Duration.Nanoseconds(), r.InputPath, r.InputSHA256)
+		if r.Verification != "" {
+			_, _ = fmt.Fprintf(w, "  verification: %s\n", r.Verification)
+		}
+		if r.Cached {
+			_, _ = fmt.Fprintln(w, "  cached: true")
+		}
+		_, _ = fmt.Fprintln(w, "  ...")
+	}
+	return nil
+}
+
+// round rounds the duration to microseconds for display
+func round(d time.Duration) time.Duration {
+	return d.Round(time.Microsecond)
+}
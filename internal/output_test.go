@@ -0,0 +1,101 @@
+package internal_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"github.com/wlchs/advent_of_code_go_template/internal"
+	"strings"
+	"testing"
+	"time"
+)
+
+var sampleResults = []internal.Result{
+	{Year: 2023, Day: 1, Part: 1, Answer: "142", Duration: time.Millisecond, InputPath: "day_01.txt", InputSHA256: "abc"},
+	{Year: 2023, Day: 26, InputPath: "day_26.txt", Err: errors.New("solver not found")},
+}
+
+func TestParseFormat(t *testing.T) {
+	t.Parallel()
+
+	if f, err := internal.ParseFormat("tap"); err != nil || f != internal.FormatTAP {
+		t.Errorf("expected tap format, but got %v, %v instead", f, err)
+	}
+	if _, err := internal.ParseFormat("xml"); !errors.Is(err, internal.ErrUnknownFormat) {
+		t.Errorf("expected ErrUnknownFormat, but got %v instead", err)
+	}
+}
+
+func TestWriteResultsJSON(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	if err := internal.WriteResults(&buf, internal.FormatJSON, sampleResults); err != nil {
+		t.Fatal(err)
+	}
+
+	var records []map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &records); err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 2 || records[0]["answer"] != "142" || records[0]["duration_ns"] != float64(time.Millisecond) {
+		t.Errorf("unexpected records %v", records)
+	}
+	if records[1]["status"] != "error" || records[1]["error"] != "solver not found" {
+		t.Errorf("expected the error to be recorded, but got %v instead", records[1])
+	}
+}
+
+func TestReadResults(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	if err := internal.WriteResults(&buf, internal.FormatJSON, sampleResults); err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := internal.ReadResults(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 2 || results[0] != sampleResults[0] {
+		t.Errorf("expected %+v, but got %+v instead", sampleResults[0], results)
+	}
+	if results[1].Err == nil || results[1].Err.Error() != "solver not found" {
+		t.Errorf("expected the error to be restored, but got %v instead", results[1].Err)
+	}
+}
+
+func TestWriteResultsCSV(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	if err := internal.WriteResults(&buf, internal.FormatCSV, sampleResults); err != nil {
+		t.Fatal(err)
+	}
+
+	rows, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 3 || strings.Join(rows[1][:4], ",") != "2023,1,1,142" || rows[1][6] != "abc" {
+		t.Errorf("unexpected rows %v", rows)
+	}
+}
+
+func TestWriteResultsTAP(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	if err := internal.WriteResults(&buf, internal.FormatTAP, sampleResults); err != nil {
+		t.Fatal(err)
+	}
+
+	out := buf.String()
+	for _, expected := range []string{"1..2\n", "ok 1 - 2023 day 1 part 1: 142\n", "not ok 2 - 2023 day 26"} {
+		if !strings.Contains(out, expected) {
+			t.Errorf("expected output to contain %q, but got %s instead", expected, out)
+		}
+	}
+}
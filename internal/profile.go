@@ -0,0 +1,109 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/pprof"
+	"runtime/trace"
+	"strings"
+)
+
+// Profile selects the profiles recorded while solving each part.
+// The file names are suffixed with the year, day and part, e.g. cpu.pprof becomes cpu_2023_day_16_part_1.pprof,
+// and are placed in Dir, or in the working directory if it's empty. Empty file names disable the profile.
+// The memory and block profiles are cumulative, they include the parts solved earlier by the same process.
+type Profile struct {
+	Dir   string
+	CPU   string
+	Mem   string
+	Trace string
+	Block string
+}
+
+// Enabled reports whether any of the profiles is recorded.
+func (p Profile) Enabled() bool {
+	return p.CPU != "" || p.Mem != "" || p.Trace != "" || p.Block != ""
+}
+
+// Path gives back the path of the profile file of a specific part.
+func (p Profile) Path(name string, year int, day int, part int) string {
+	ext := filepath.Ext(name)
+	base := strings.TrimSuffix(name, ext)
+	return filepath.Join(p.Dir, fmt.Sprintf("%s_%d_day_%02d_part_%d%s", base, year, day, part, ext))
+}
+
+// start begins recording the enabled profiles of a part.
+// The returned function stops the recording and writes the remaining profiles.
+func (p Profile) start(year int, day int, part int) (func() error, error) {
+	var stops []func() error
+	stop := func() error {
+		var errs []error
+		for i := len(stops) - 1; i >= 0; i-- {
+			errs = append(errs, stops[i]())
+		}
+		return errors.Join(errs...)
+	}
+
+	if p.Dir != "" {
+		if err := os.MkdirAll(p.Dir, 0o755); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.CPU != "" {
+		f, err := os.Create(p.Path(p.CPU, year, day, part))
+		if err != nil {
+			return nil, errors.Join(err, stop())
+		}
+		if err := pprof.StartCPUProfile(f); err != nil {
+			return nil, errors.Join(err, f.Close(), stop())
+		}
+		stops = append(stops, func() error {
+			pprof.StopCPUProfile()
+			return f.Close()
+		})
+	}
+
+	if p.Trace != "" {
+		f, err := os.Create(p.Path(p.Trace, year, day, part))
+		if err != nil {
+			return nil, errors.Join(err, stop())
+		}
+		if err := trace.Start(f); err != nil {
+			return nil, errors.Join(err, f.Close(), stop())
+		}
+		stops = append(stops, func() error {
+			trace.Stop()
+			return f.Close()
+		})
+	}
+
+	if p.Block != "" {
+		runtime.SetBlockProfileRate(1)
+		stops = append(stops, func() error {
+			defer runtime.SetBlockProfileRate(0)
+			return writeProfile("block", p.Path(p.Block, year, day, part))
+		})
+	}
+
+	if p.Mem != "" {
+		stops = append(stops, func() error {
+			runtime.GC()
+			return writeProfile("allocs", p.Path(p.Mem, year, day, part))
+		})
+	}
+
+	return stop, nil
+}
+
+// writeProfile writes the named runtime profile to the given path
+func writeProfile(name string, path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	return errors.Join(pprof.Lookup(name).WriteTo(f, 0), f.Close())
+}
@@ -0,0 +1,45 @@
+package internal_test
+
+import (
+	"context"
+	"github.com/wlchs/advent_of_code_go_template/internal"
+	_ "github.com/wlchs/advent_of_code_go_template/years"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestProfilePath(t *testing.T) {
+	t.Parallel()
+
+	p := internal.Profile{Dir: "profiles"}
+	if path := p.Path("cpu.pprof", 2023, 16, 1); path != filepath.Join("profiles", "cpu_2023_day_16_part_1.pprof") {
+		t.Errorf("unexpected profile path %s", path)
+	}
+	if path := p.Path("trace", 2023, 5, 2); path != filepath.Join("profiles", "trace_2023_day_05_part_2") {
+		t.Errorf("unexpected profile path %s", path)
+	}
+}
+
+func TestRunChallengeProfile(t *testing.T) {
+	t.Parallel()
+
+	p := internal.Profile{Dir: filepath.Join(t.TempDir(), "profiles"), CPU: "cpu.pprof", Mem: "mem.pprof", Trace: "trace.out", Block: "block.pprof"}
+	results := internal.RunChallenge(context.Background(), 2023, 16, "../years/2023/day_16/input_1_test.txt", internal.RunOptions{Profile: p})
+	for _, r := range results {
+		if r.Err != nil {
+			t.Fatal(r.Err)
+		}
+	}
+
+	for _, part := range []int{1, 2} {
+		for _, name := range []string{p.CPU, p.Mem, p.Trace, p.Block} {
+			info, err := os.Stat(p.Path(name, 2023, 16, part))
+			if err != nil {
+				t.Error(err)
+			} else if info.Size() == 0 {
+				t.Errorf("expected a non-empty profile %s", info.Name())
+			}
+		}
+	}
+}
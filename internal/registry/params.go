@@ -0,0 +1,40 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// paramsKey is the context key of the solver parameters
+type paramsKey struct{}
+
+// WithParams attaches the parameters of a day to the context, so that its solver can override its built-in constants,
+// such as the number of steps of day 21.
+func WithParams(ctx context.Context, params map[string]string) context.Context {
+	if len(params) == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, paramsKey{}, params)
+}
+
+// Param gives back the parameter with the given name attached to the context.
+func Param(ctx context.Context, name string) (string, bool) {
+	params, _ := ctx.Value(paramsKey{}).(map[string]string)
+	value, ok := params[name]
+	return value, ok
+}
+
+// IntParam gives back the integer parameter with the given name attached to the context, or the default if it's
+// not set.
+func IntParam(ctx context.Context, name string, def int) (int, error) {
+	value, ok := Param(ctx, name)
+	if !ok {
+		return def, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid parameter %s: %w", name, err)
+	}
+	return n, nil
+}
@@ -0,0 +1,123 @@
+package registry
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// ErrSolverNotFound is returned when no solver is registered for the requested challenge.
+var ErrSolverNotFound = errors.New("solver not found")
+
+// ErrPartNotImplemented is returned when a solver doesn't provide the requested part of the challenge.
+var ErrPartNotImplemented = errors.New("part not implemented")
+
+// key identifies a single daily challenge
+type key struct {
+	year int
+	day  int
+}
+
+// Registry stores the solvers of the daily challenges.
+type Registry struct {
+	mu      sync.RWMutex
+	solvers map[key]Solver
+}
+
+// defaultRegistry is the registry the daily challenges register themselves into
+var defaultRegistry = NewRegistry()
+
+// NewRegistry creates an empty solver registry.
+func NewRegistry() *Registry {
+	return &Registry{solvers: map[key]Solver{}}
+}
+
+// Register adds the solver to the registry.
+// It panics if a solver is already registered for the same year and day.
+func (r *Registry) Register(s Solver) Solver {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	k := key{year: s.Year(), day: s.Day()}
+	if _, ok := r.solvers[k]; ok {
+		panic(fmt.Sprintf("solver for %d day %d registered twice", k.year, k.day))
+	}
+	r.solvers[k] = s
+	return s
+}
+
+// Lookup finds the solver of the given year and day.
+func (r *Registry) Lookup(year int, day int) (Solver, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	s, ok := r.solvers[key{year: year, day: day}]
+	if !ok {
+		return nil, fmt.Errorf("%w for %d day %d", ErrSolverNotFound, year, day)
+	}
+	return s, nil
+}
+
+// All returns every registered solver ordered by year and day.
+func (r *Registry) All() []Solver {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	res := make([]Solver, 0, len(r.solvers))
+	for _, s := range r.solvers {
+		res = append(res, s)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Year() != res[j].Year() {
+			return res[i].Year() < res[j].Year()
+		}
+		return res[i].Day() < res[j].Day()
+	})
+	return res
+}
+
+// Years returns the years having at least one registered solver in ascending order.
+func (r *Registry) Years() []int {
+	var years []int
+	for _, s := range r.All() {
+		if len(years) == 0 || years[len(years)-1] != s.Year() {
+			years = append(years, s.Year())
+		}
+	}
+	return years
+}
+
+// LatestYear returns the most recent year having a registered solver, or 0 if the registry is empty.
+func (r *Registry) LatestYear() int {
+	years := r.Years()
+	if len(years) == 0 {
+		return 0
+	}
+	return years[len(years)-1]
+}
+
+// Register adds the solver to the default registry.
+func Register(s Solver) Solver {
+	return defaultRegistry.Register(s)
+}
+
+// Lookup finds the solver of the given year and day in the default registry.
+func Lookup(year int, day int) (Solver, error) {
+	return defaultRegistry.Lookup(year, day)
+}
+
+// All returns every solver of the default registry ordered by year and day.
+func All() []Solver {
+	return defaultRegistry.All()
+}
+
+// Years returns the years of the default registry having at least one registered solver in ascending order.
+func Years() []int {
+	return defaultRegistry.Years()
+}
+
+// LatestYear returns the most recent year of the default registry having a registered solver.
+func LatestYear() int {
+	return defaultRegistry.LatestYear()
+}
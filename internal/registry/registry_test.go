@@ -0,0 +1,96 @@
+package registry_test
+
+import (
+	"context"
+	"errors"
+	"github.com/wlchs/advent_of_code_go_template/internal/registry"
+	"testing"
+)
+
+func echo(input []string) string {
+	return input[0]
+}
+
+func TestLookup(t *testing.T) {
+	t.Parallel()
+
+	r := registry.NewRegistry()
+	r.Register(registry.NewSolver(2023, 1, "first", echo, echo))
+
+	s, err := r.Lookup(2023, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if s.Title() != "first" {
+		t.Errorf("expected title first, but got %s instead", s.Title())
+	}
+
+	if _, err := r.Lookup(2023, 26); !errors.Is(err, registry.ErrSolverNotFound) {
+		t.Errorf("expected ErrSolverNotFound, but got %v instead", err)
+	}
+}
+
+func TestAll(t *testing.T) {
+	t.Parallel()
+
+	r := registry.NewRegistry()
+	r.Register(registry.NewSolver(2023, 2, "", echo, nil))
+	r.Register(registry.NewSolver(2022, 7, "", echo, nil))
+	r.Register(registry.NewSolver(2023, 1, "", echo, nil))
+
+	if years := r.Years(); len(years) != 2 || years[0] != 2022 || years[1] != 2023 {
+		t.Errorf("expected years 2022 and 2023, but got %v instead", years)
+	}
+	if latest := r.LatestYear(); latest != 2023 {
+		t.Errorf("expected latest year 2023, but got %d instead", latest)
+	}
+
+	all := r.All()
+	expected := [][2]int{{2022, 7}, {2023, 1}, {2023, 2}}
+	if len(all) != len(expected) {
+		t.Fatalf("expected %d solvers, but got %d instead", len(expected), len(all))
+	}
+	for i, s := range all {
+		if s.Year() != expected[i][0] || s.Day() != expected[i][1] {
+			t.Errorf("expected %v at index %d, but got %d day %d instead", expected[i], i, s.Year(), s.Day())
+		}
+	}
+}
+
+func TestRegisterTwice(t *testing.T) {
+	t.Parallel()
+
+	r := registry.NewRegistry()
+	r.Register(registry.NewSolver(2023, 1, "", echo, nil))
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic on duplicate registration")
+		}
+	}()
+	r.Register(registry.NewSolver(2023, 1, "", echo, nil))
+}
+
+func TestSolve(t *testing.T) {
+	t.Parallel()
+
+	input := []string{"answer"}
+	onePart := registry.NewSolver(2023, 25, "", echo, nil)
+	twoParts := registry.NewSolver(2023, 24, "", echo, echo)
+
+	if res, err := registry.Solve(context.Background(), twoParts, 2, input); err != nil || res != "answer" {
+		t.Errorf("expected answer, but got %s, %v instead", res, err)
+	}
+	if _, err := registry.Solve(context.Background(), onePart, 2, input); !errors.Is(err, registry.ErrPartNotImplemented) {
+		t.Errorf("expected ErrPartNotImplemented, but got %v instead", err)
+	}
+	if parts := registry.Parts(onePart); len(parts) != 1 {
+		t.Errorf("expected a single part, but got %v instead", parts)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := registry.Solve(ctx, twoParts, 1, input); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, but got %v instead", err)
+	}
+}
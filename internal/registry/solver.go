@@ -0,0 +1,118 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+)
+
+// PartFunc solves one part of a daily challenge.
+type PartFunc func(input []string) string
+
+// ContextPartFunc solves one part of a daily challenge and stops early with the context's error once it's done.
+type ContextPartFunc func(ctx context.Context, input []string) (string, error)
+
+// Solver describes the solution of a single daily challenge.
+type Solver interface {
+	Year() int
+	Day() int
+	Title() string
+	Part1(ctx context.Context, input []string) (string, error)
+}
+
+// PartTwoSolver is implemented by solvers that also solve the second part of the challenge.
+// The last day of an event usually has a single part only.
+type PartTwoSolver interface {
+	Solver
+	Part2(ctx context.Context, input []string) (string, error)
+}
+
+// puzzle is a Solver built from plain functions
+type puzzle struct {
+	year  int
+	day   int
+	title string
+	part1 ContextPartFunc
+}
+
+// twoPartPuzzle is a puzzle that also solves the second part of the challenge
+type twoPartPuzzle struct {
+	puzzle
+	part2 ContextPartFunc
+}
+
+// NewSolver creates a Solver from the part functions of a daily challenge.
+// If part2 is nil, the returned solver doesn't implement PartTwoSolver.
+func NewSolver(year int, day int, title string, part1 PartFunc, part2 PartFunc) Solver {
+	var p2 ContextPartFunc
+	if part2 != nil {
+		p2 = IgnoreContext(part2)
+	}
+	return NewContextSolver(year, day, title, IgnoreContext(part1), p2)
+}
+
+// NewContextSolver creates a Solver from cancellable part functions of a daily challenge.
+// If part2 is nil, the returned solver doesn't implement PartTwoSolver.
+func NewContextSolver(year int, day int, title string, part1 ContextPartFunc, part2 ContextPartFunc) Solver {
+	p := puzzle{year: year, day: day, title: title, part1: part1}
+	if part2 == nil {
+		return p
+	}
+	return twoPartPuzzle{puzzle: p, part2: part2}
+}
+
+// IgnoreContext adapts a part function that runs quickly enough not to need cancellation.
+// The part function doesn't start if the context is already done.
+func IgnoreContext(f PartFunc) ContextPartFunc {
+	return func(ctx context.Context, input []string) (string, error) {
+		if err := ctx.Err(); err != nil {
+			return "", err
+		}
+		return f(input), nil
+	}
+}
+
+// Year of the event
+func (p puzzle) Year() int {
+	return p.year
+}
+
+// Day of the challenge
+func (p puzzle) Day() int {
+	return p.day
+}
+
+// Title of the challenge
+func (p puzzle) Title() string {
+	return p.title
+}
+
+// Part1 solves the first part of the challenge
+func (p puzzle) Part1(ctx context.Context, input []string) (string, error) {
+	return p.part1(ctx, input)
+}
+
+// Part2 solves the second part of the challenge
+func (p twoPartPuzzle) Part2(ctx context.Context, input []string) (string, error) {
+	return p.part2(ctx, input)
+}
+
+// Parts lists the parts of the challenge the solver implements.
+func Parts(s Solver) []int {
+	if _, ok := s.(PartTwoSolver); ok {
+		return []int{1, 2}
+	}
+	return []int{1}
+}
+
+// Solve runs the given part of the challenge with the provided input.
+func Solve(ctx context.Context, s Solver, part int, input []string) (string, error) {
+	switch part {
+	case 1:
+		return s.Part1(ctx, input)
+	case 2:
+		if p2, ok := s.(PartTwoSolver); ok {
+			return p2.Part2(ctx, input)
+		}
+	}
+	return "", fmt.Errorf("%w: %d day %d part %d", ErrPartNotImplemented, s.Year(), s.Day(), part)
+}
@@ -0,0 +1,178 @@
+package scaffold
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/template"
+)
+
+// ErrDayExists is returned when the package of the day to generate already exists.
+var ErrDayExists = errors.New("day already exists")
+
+// ErrInvalidDay is returned when the day is outside the range of an event.
+var ErrInvalidDay = errors.New("invalid day")
+
+// ErrInvalidYear is returned when the year doesn't have 4 digits, so its days couldn't be linked into the binary.
+var ErrInvalidYear = errors.New("invalid year")
+
+//go:embed templates/*.tmpl
+var templateFS embed.FS
+
+// templates are the parsed templates of the generated files
+var templates = template.Must(template.ParseFS(templateFS, "templates/*.tmpl"))
+
+// yearDir matches the directory names of the years
+var yearDir = regexp.MustCompile(`^\d{4}$`)
+
+// dayPackage matches the directory names of the day packages
+var dayPackage = regexp.MustCompile(`^day_\d{2}$`)
+
+// Options of the generated day
+type Options struct {
+	// Root is the project root containing the go.mod file
+	Root  string
+	Year  int
+	Day   int
+	Title string
+}
+
+// dayData is the input of the day templates
+type dayData struct {
+	Options
+	Module  string
+	Package string
+}
+
+// Generate creates the package of a new day from the templates and links it into the binary.
+// The package contains the solver with empty parts, the tests and empty example input and solution files.
+// It refuses to overwrite an existing day and gives back the directory of the new package. If any of the steps fails,
+// the partially generated package is removed, so that the day can be generated again.
+func Generate(opts Options) (string, error) {
+	if opts.Day < 1 || opts.Day > 25 {
+		return "", fmt.Errorf("%w %d, expected 1-25", ErrInvalidDay, opts.Day)
+	}
+	if !yearDir.MatchString(fmt.Sprint(opts.Year)) {
+		return "", fmt.Errorf("%w %d, expected 4 digits", ErrInvalidYear, opts.Year)
+	}
+
+	module, err := modulePath(opts.Root)
+	if err != nil {
+		return "", err
+	}
+
+	pkg := fmt.Sprintf("day_%02d", opts.Day)
+	dir := filepath.Join(opts.Root, "years", fmt.Sprint(opts.Year), pkg)
+	if _, err := os.Stat(dir); err == nil {
+		return "", fmt.Errorf("%w: %s", ErrDayExists, dir)
+	}
+
+	// the sources are rendered before anything is created, so that a broken template leaves no trace
+	data := dayData{
+		Options: opts,
+		Module:  module,
+		Package: pkg,
+	}
+	files := map[string][]byte{}
+	for name, tmpl := range map[string]string{"main.go": "main.go.tmpl", "main_test.go": "main_test.go.tmpl"} {
+		if files[name], err = source(filepath.Join(dir, name), tmpl, data); err != nil {
+			return "", err
+		}
+	}
+	for _, part := range []int{1, 2} {
+		files[fmt.Sprintf("input_%d_test.txt", part)] = nil
+		files[fmt.Sprintf("solution_%d.txt", part)] = nil
+	}
+
+	if err := create(dir, files, module, opts.Root); err != nil {
+		return "", errors.Join(err, os.RemoveAll(dir))
+	}
+	return dir, nil
+}
+
+// create writes the files of the day package into the directory and links the package into the binary
+func create(dir string, files map[string][]byte, module string, root string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), content, 0o644); err != nil {
+			return err
+		}
+	}
+	return link(root, module)
+}
+
+// link regenerates the years package, so that every day package of every year is imported and registers its solver
+func link(root string, module string) error {
+	years, err := os.ReadDir(filepath.Join(root, "years"))
+	if err != nil {
+		return err
+	}
+
+	var packages []string
+	for _, y := range years {
+		if !y.IsDir() || !yearDir.MatchString(y.Name()) {
+			continue
+		}
+		days, err := os.ReadDir(filepath.Join(root, "years", y.Name()))
+		if err != nil {
+			return err
+		}
+		for _, d := range days {
+			if d.IsDir() && dayPackage.MatchString(d.Name()) {
+				packages = append(packages, y.Name()+"/"+d.Name())
+			}
+		}
+	}
+
+	data := struct {
+		Module   string
+		Packages []string
+	}{Module: module, Packages: packages}
+	return render(filepath.Join(root, "years", "years.go"), "years.go.tmpl", data)
+}
+
+// render executes the template and writes the formatted source to the given path
+func render(path string, name string, data any) error {
+	src, err := source(path, name, data)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, src, 0o644)
+}
+
+// source executes the template and formats the source of the file at the given path
+func source(path string, name string, data any) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := templates.ExecuteTemplate(&buf, name, data); err != nil {
+		return nil, err
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to format %s: %w", path, err)
+	}
+	return src, nil
+}
+
+// modulePath reads the module path from the go.mod file of the project root
+func modulePath(root string) (string, error) {
+	data, err := os.ReadFile(filepath.Join(root, "go.mod"))
+	if err != nil {
+		return "", err
+	}
+
+	for _, line := range strings.Split(string(data), "\n") {
+		if module, ok := strings.CutPrefix(strings.TrimSpace(line), "module "); ok {
+			return strings.TrimSpace(module), nil
+		}
+	}
+	return "", fmt.Errorf("missing module directive in %s", filepath.Join(root, "go.mod"))
+}
@@ -0,0 +1,96 @@
+package scaffold_test
+
+import (
+	"errors"
+	"github.com/wlchs/advent_of_code_go_template/internal/scaffold"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	if err := os.WriteFile(filepath.Join(root, "go.mod"), []byte("module example.com/aoc\n\ngo 1.21.4\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(root, "years", "2023", "day_01"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	dir, err := scaffold.Generate(scaffold.Options{Root: root, Year: 2024, Day: 2, Title: "Red-Nosed \"Reports\""})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, name := range []string{"main.go", "main_test.go", "input_1_test.txt", "input_2_test.txt", "solution_1.txt", "solution_2.txt"} {
+		if _, err := os.Stat(filepath.Join(dir, name)); err != nil {
+			t.Errorf("expected %s to be generated: %v", name, err)
+		}
+	}
+
+	main, err := os.ReadFile(filepath.Join(dir, "main.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := `registry.NewSolver(2024, 2, "Red-Nosed \"Reports\"", Part1, Part2)`; !strings.Contains(string(main), expected) {
+		t.Errorf("expected main.go to register the solver, but got %s instead", main)
+	}
+
+	if expected := filepath.Join(root, "years", "2024", "day_02"); dir != expected {
+		t.Errorf("expected the package in %s, but got %s instead", expected, dir)
+	}
+
+	years, err := os.ReadFile(filepath.Join(root, "years", "years.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, pkg := range []string{`_ "example.com/aoc/years/2023/day_01"`, `_ "example.com/aoc/years/2024/day_02"`} {
+		if !strings.Contains(string(years), pkg) {
+			t.Errorf("expected years.go to import %s, but got %s instead", pkg, years)
+		}
+	}
+
+	if _, err := scaffold.Generate(scaffold.Options{Root: root, Year: 2024, Day: 2}); !errors.Is(err, scaffold.ErrDayExists) {
+		t.Errorf("expected ErrDayExists, but got %v instead", err)
+	}
+	if _, err := scaffold.Generate(scaffold.Options{Root: root, Year: 2024, Day: 26}); !errors.Is(err, scaffold.ErrInvalidDay) {
+		t.Errorf("expected ErrInvalidDay, but got %v instead", err)
+	}
+	for _, year := range []int{0, 999, 20240} {
+		if _, err := scaffold.Generate(scaffold.Options{Root: root, Year: year, Day: 1}); !errors.Is(err, scaffold.ErrInvalidYear) {
+			t.Errorf("expected ErrInvalidYear for %d, but got %v instead", year, err)
+		}
+	}
+}
+
+func TestGenerateCleanup(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	if err := os.WriteFile(filepath.Join(root, "go.mod"), []byte("module example.com/aoc\n\ngo 1.21.4\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	// a directory in place of years.go makes linking the new day fail
+	years := filepath.Join(root, "years", "years.go")
+	if err := os.MkdirAll(years, 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	opts := scaffold.Options{Root: root, Year: 2024, Day: 3}
+	if _, err := scaffold.Generate(opts); err == nil {
+		t.Fatal("expected linking the day to fail")
+	}
+	if _, err := os.Stat(filepath.Join(root, "years", "2024", "day_03")); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("expected the partially generated day to be removed, but got %v instead", err)
+	}
+
+	if err := os.Remove(years); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := scaffold.Generate(opts); err != nil {
+		t.Errorf("expected the day to be generated again, but got %v instead", err)
+	}
+}
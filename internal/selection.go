@@ -0,0 +1,75 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"github.com/wlchs/advent_of_code_go_template/internal/registry"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidDaySelection is returned when the day selector can't be parsed.
+var ErrInvalidDaySelection = errors.New("invalid day selection")
+
+// ErrInvalidPartSelection is returned when the part selector can't be parsed.
+var ErrInvalidPartSelection = errors.New("invalid part selection")
+
+// ParseDays parses a day selector of the given year.
+// The selector is either "all" for every registered day or a comma separated list of days and
+// inclusive day ranges, e.g. "1-10,17". The returned days are sorted and free of duplicates.
+func ParseDays(year int, selector string) ([]int, error) {
+	if selector == "all" {
+		var days []int
+		for _, s := range registry.All() {
+			if s.Year() == year {
+				days = append(days, s.Day())
+			}
+		}
+		return days, nil
+	}
+
+	var days []int
+	for _, part := range strings.Split(selector, ",") {
+		first, last, isRange := strings.Cut(strings.TrimSpace(part), "-")
+		from, err := strconv.Atoi(first)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidDaySelection, part)
+		}
+		to := from
+		if isRange {
+			if to, err = strconv.Atoi(last); err != nil || to < from {
+				return nil, fmt.Errorf("%w: %q", ErrInvalidDaySelection, part)
+			}
+		}
+		if from < 1 || to > 25 {
+			return nil, fmt.Errorf("%w: %q, days range from 1 to 25", ErrInvalidDaySelection, part)
+		}
+		for day := from; day <= to; day++ {
+			days = append(days, day)
+		}
+	}
+
+	slices.Sort(days)
+	return slices.Compact(days), nil
+}
+
+// ParseParts parses a part selector: "all" or an empty selector for both parts, otherwise a comma separated list of
+// parts, e.g. "1,2". The returned parts are sorted and free of duplicates.
+func ParseParts(selector string) ([]int, error) {
+	if selector == "" || selector == "all" {
+		return []int{1, 2}, nil
+	}
+
+	var parts []int
+	for _, p := range strings.Split(selector, ",") {
+		part, err := strconv.Atoi(strings.TrimSpace(p))
+		if err != nil || (part != 1 && part != 2) {
+			return nil, fmt.Errorf("%w: %q, expected 1, 2 or all", ErrInvalidPartSelection, p)
+		}
+		parts = append(parts, part)
+	}
+
+	slices.Sort(parts)
+	return slices.Compact(parts), nil
+}
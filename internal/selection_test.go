@@ -0,0 +1,49 @@
+package internal_test
+
+import (
+	"errors"
+	"github.com/wlchs/advent_of_code_go_template/internal"
+	_ "github.com/wlchs/advent_of_code_go_template/years"
+	"slices"
+	"testing"
+)
+
+func TestParseDays(t *testing.T) {
+	t.Parallel()
+
+	days, err := internal.ParseDays(2023, "1-3, 17,2")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := []int{1, 2, 3, 17}; !slices.Equal(days, expected) {
+		t.Errorf("expected %v, but got %v instead", expected, days)
+	}
+
+	all, err := internal.ParseDays(2023, "all")
+	if err != nil || len(all) != 25 {
+		t.Errorf("expected all 25 days, but got %v, %v instead", all, err)
+	}
+
+	for _, selector := range []string{"", "x", "5-3", "1-", "0", "20-26"} {
+		if _, err := internal.ParseDays(2023, selector); !errors.Is(err, internal.ErrInvalidDaySelection) {
+			t.Errorf("expected ErrInvalidDaySelection for %q, but got %v instead", selector, err)
+		}
+	}
+}
+
+func TestParseParts(t *testing.T) {
+	t.Parallel()
+
+	for selector, expected := range map[string][]int{"": {1, 2}, "all": {1, 2}, "2": {2}, "2, 1,2": {1, 2}} {
+		parts, err := internal.ParseParts(selector)
+		if err != nil || !slices.Equal(parts, expected) {
+			t.Errorf("expected %v for %q, but got %v, %v instead", expected, selector, parts, err)
+		}
+	}
+
+	for _, selector := range []string{"3", "0", "1,x", "1-2"} {
+		if _, err := internal.ParseParts(selector); !errors.Is(err, internal.ErrInvalidPartSelection) {
+			t.Errorf("expected ErrInvalidPartSelection for %q, but got %v instead", selector, err)
+		}
+	}
+}
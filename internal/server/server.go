@@ -0,0 +1,249 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/wlchs/advent_of_code_go_template/internal"
+	"github.com/wlchs/advent_of_code_go_template/internal/registry"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RequestInputPath is recorded as the input path of the results solved from a request body
+const RequestInputPath = "request"
+
+// DefaultMaxInputBytes is the size limit of the posted inputs if the options don't set one
+const DefaultMaxInputBytes = 1 << 20
+
+// Options of the server
+type Options struct {
+	// MaxInputBytes limits the size of the posted inputs, DefaultMaxInputBytes is used if it's not positive
+	MaxInputBytes int64
+	// Timeout limits the run time of each solved part, 0 means no limit
+	Timeout time.Duration
+}
+
+// Server exposes the registered solvers over HTTP.
+// GET /days lists the registered days, POST /solve?year=2023&day=5&part=1 solves the posted input and
+// GET /stats reports the timing statistics of the parts solved so far.
+type Server struct {
+	opts  Options
+	mux   *http.ServeMux
+	mu    sync.Mutex
+	stats map[statsKey]*Stats
+}
+
+// Day describes a registered solver
+type Day struct {
+	Year  int    `json:"year"`
+	Day   int    `json:"day"`
+	Title string `json:"title"`
+	Parts []int  `json:"parts"`
+}
+
+// Stats contains the timing statistics of a part solved by the server
+type Stats struct {
+	Year   int   `json:"year"`
+	Day    int   `json:"day"`
+	Part   int   `json:"part"`
+	Runs   int   `json:"runs"`
+	Errors int   `json:"errors"`
+	MinNs  int64 `json:"min_ns"`
+	MaxNs  int64 `json:"max_ns"`
+	MeanNs int64 `json:"mean_ns"`
+	LastNs int64 `json:"last_ns"`
+	// totalNs is the sum of the run times, used for calculating the mean
+	totalNs int64
+}
+
+// statsKey identifies the statistics of a part
+type statsKey struct {
+	year int
+	day  int
+	part int
+}
+
+// New creates a server with the given options.
+func New(opts Options) *Server {
+	if opts.MaxInputBytes <= 0 {
+		opts.MaxInputBytes = DefaultMaxInputBytes
+	}
+	s := &Server{opts: opts, mux: http.NewServeMux(), stats: map[statsKey]*Stats{}}
+	s.mux.HandleFunc("/days", s.handleDays)
+	s.mux.HandleFunc("/solve", s.handleSolve)
+	s.mux.HandleFunc("/stats", s.handleStats)
+	return s
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+// handleDays lists the registered days
+func (s *Server) handleDays(w http.ResponseWriter, r *http.Request) {
+	if !allowMethod(w, r, http.MethodGet) {
+		return
+	}
+
+	days := []Day{}
+	for _, solver := range registry.All() {
+		days = append(days, Day{Year: solver.Year(), Day: solver.Day(), Title: solver.Title(), Parts: registry.Parts(solver)})
+	}
+	writeJSON(w, http.StatusOK, days)
+}
+
+// handleSolve solves the posted input with the selected day's solver and responds with the results.
+// The year defaults to the most recent one with solvers, and both parts are solved if the part is omitted.
+func (s *Server) handleSolve(w http.ResponseWriter, r *http.Request) {
+	if !allowMethod(w, r, http.MethodPost) {
+		return
+	}
+
+	query := r.URL.Query()
+	year, err := intParam(query.Get("year"), registry.LatestYear())
+	if err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("invalid year: %w", err))
+		return
+	}
+	day, err := intParam(query.Get("day"), 0)
+	if err != nil || day == 0 {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("invalid or missing day %q", query.Get("day")))
+		return
+	}
+	parts, err := internal.ParseParts(query.Get("part"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, s.opts.MaxInputBytes))
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		writeError(w, http.StatusRequestEntityTooLarge, fmt.Errorf("input exceeds %d bytes", maxBytesErr.Limit))
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	input := internal.NewInput(RequestInputPath, data)
+	results := internal.SolveInput(r.Context(), year, day, input, internal.RunOptions{Parts: parts, Timeout: s.opts.Timeout})
+	if len(results) == 1 && errors.Is(results[0].Err, registry.ErrSolverNotFound) {
+		writeError(w, http.StatusNotFound, results[0].Err)
+		return
+	}
+	if len(results) == 1 && results[0].NotApplicable {
+		writeError(w, http.StatusNotFound, fmt.Errorf("%w: %d day %d part %d", registry.ErrPartNotImplemented, year, day, results[0].Part))
+		return
+	}
+
+	status := http.StatusOK
+	for _, res := range results {
+		s.record(res)
+		if errors.Is(res.Err, internal.ErrTimeout) {
+			status = http.StatusGatewayTimeout
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = internal.WriteResults(w, internal.FormatJSON, results)
+}
+
+// handleStats reports the timing statistics of the solved parts
+func (s *Server) handleStats(w http.ResponseWriter, r *http.Request) {
+	if !allowMethod(w, r, http.MethodGet) {
+		return
+	}
+	writeJSON(w, http.StatusOK, s.Stats())
+}
+
+// Stats gives back the timing statistics of the parts solved so far, ordered by year, day and part.
+func (s *Server) Stats() []Stats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stats := make([]Stats, 0, len(s.stats))
+	for _, st := range s.stats {
+		stats = append(stats, *st)
+	}
+	slices.SortFunc(stats, func(a, b Stats) int {
+		if a.Year != b.Year {
+			return a.Year - b.Year
+		}
+		if a.Day != b.Day {
+			return a.Day - b.Day
+		}
+		return a.Part - b.Part
+	})
+	return stats
+}
+
+// record adds the result to the timing statistics of its part, parts the day doesn't have are left out
+func (s *Server) record(res internal.Result) {
+	if res.NotApplicable {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := statsKey{year: res.Year, day: res.Day, part: res.Part}
+	st, ok := s.stats[key]
+	if !ok {
+		st = &Stats{Year: res.Year, Day: res.Day, Part: res.Part}
+		s.stats[key] = st
+	}
+	if res.Err != nil {
+		st.Errors++
+		return
+	}
+
+	ns := res.Duration.Nanoseconds()
+	if st.Runs == 0 || ns < st.MinNs {
+		st.MinNs = ns
+	}
+	st.MaxNs = max(st.MaxNs, ns)
+	st.Runs++
+	st.totalNs += ns
+	st.MeanNs = st.totalNs / int64(st.Runs)
+	st.LastNs = ns
+}
+
+// allowMethod responds with 405 Method Not Allowed if the request has a different method
+func allowMethod(w http.ResponseWriter, r *http.Request, method string) bool {
+	if r.Method == method {
+		return true
+	}
+	w.Header().Set("Allow", method)
+	writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed, use %s", r.Method, method))
+	return false
+}
+
+// intParam parses an integer query parameter, the default is used if it's empty
+func intParam(value string, def int) (int, error) {
+	if value == "" {
+		return def, nil
+	}
+	return strconv.Atoi(value)
+}
+
+// writeError responds with the error message as JSON
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
+
+// writeJSON responds with the value encoded as JSON
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	_ = enc.Encode(v)
+}
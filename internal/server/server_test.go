@@ -0,0 +1,166 @@
+package server_test
+
+import (
+	"encoding/json"
+	"github.com/wlchs/advent_of_code_go_template/internal/server"
+	_ "github.com/wlchs/advent_of_code_go_template/years"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+// result is the subset of the JSON results checked by the tests
+type result struct {
+	Day    int    `json:"day"`
+	Part   int    `json:"part"`
+	Answer string `json:"answer"`
+	Input  string `json:"input"`
+	Status string `json:"status"`
+	Error  string `json:"error"`
+}
+
+func TestDays(t *testing.T) {
+	t.Parallel()
+
+	srv := newServer(t, server.Options{})
+
+	var days []server.Day
+	get(t, srv.URL+"/days", http.StatusOK, &days)
+	if len(days) != 25 || days[0].Title != "Trebuchet?!" || len(days[24].Parts) != 1 {
+		t.Errorf("unexpected days %+v", days)
+	}
+}
+
+func TestSolve(t *testing.T) {
+	t.Parallel()
+
+	srv := newServer(t, server.Options{})
+
+	example, err := os.ReadFile("../../years/2023/day_01/input_2_test.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var results []result
+	post(t, srv.URL+"/solve?year=2023&day=1&part=2", string(example), http.StatusOK, &results)
+	if len(results) != 1 || results[0].Answer != "281" || results[0].Input != server.RequestInputPath || results[0].Status != "ok" {
+		t.Errorf("unexpected results %+v", results)
+	}
+
+	// the second example has no digits in one of its lines, so the first part panics
+	post(t, srv.URL+"/solve?day=1", string(example), http.StatusOK, &results)
+	if len(results) != 2 || results[0].Status != "error" || results[1].Answer != "281" {
+		t.Errorf("unexpected results %+v", results)
+	}
+
+	var stats []server.Stats
+	get(t, srv.URL+"/stats", http.StatusOK, &stats)
+	if len(stats) != 2 || stats[0].Part != 1 || stats[0].Errors != 1 || stats[1].Runs != 2 || stats[1].MinNs > stats[1].MaxNs {
+		t.Errorf("unexpected stats %+v", stats)
+	}
+}
+
+func TestSolveErrors(t *testing.T) {
+	t.Parallel()
+
+	srv := newServer(t, server.Options{MaxInputBytes: 16})
+
+	tests := []struct {
+		name   string
+		query  string
+		input  string
+		status int
+	}{
+		{name: "missing day", query: "", status: http.StatusBadRequest},
+		{name: "invalid part", query: "day=1&part=3", status: http.StatusBadRequest},
+		{name: "unknown day", query: "year=2015&day=1", status: http.StatusNotFound},
+		{name: "missing part", query: "day=25&part=2", status: http.StatusNotFound},
+		{name: "too large", query: "day=1", input: strings.Repeat("1abc2\n", 10), status: http.StatusRequestEntityTooLarge},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var body map[string]string
+			post(t, srv.URL+"/solve?"+tt.query, tt.input, tt.status, &body)
+			if body["error"] == "" {
+				t.Error("expected an error message")
+			}
+		})
+	}
+
+	resp, err := http.Get(srv.URL + "/solve?day=1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusMethodNotAllowed || resp.Header.Get("Allow") != http.MethodPost {
+		t.Errorf("expected 405 allowing POST, but got %d, %q instead", resp.StatusCode, resp.Header.Get("Allow"))
+	}
+}
+
+func TestSolveTimeout(t *testing.T) {
+	t.Parallel()
+
+	srv := newServer(t, server.Options{Timeout: time.Nanosecond})
+
+	example, err := os.ReadFile("../../years/2023/day_23/input_1_test.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var results []result
+	post(t, srv.URL+"/solve?day=23&part=1", string(example), http.StatusGatewayTimeout, &results)
+	if len(results) != 1 || !strings.HasPrefix(results[0].Error, "timed out") {
+		t.Errorf("unexpected results %+v", results)
+	}
+}
+
+// newServer starts a test server that is closed at the end of the test
+func newServer(t *testing.T, opts server.Options) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewServer(server.New(opts))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+// get requests the URL and decodes the JSON response after checking its status code
+func get(t *testing.T, url string, status int, v any) {
+	t.Helper()
+
+	resp, err := http.Get(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decode(t, resp, status, v)
+}
+
+// post sends the body to the URL and decodes the JSON response after checking its status code
+func post(t *testing.T, url string, body string, status int, v any) {
+	t.Helper()
+
+	resp, err := http.Post(url, "text/plain", strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	decode(t, resp, status, v)
+}
+
+// decode checks the status code of the response and decodes its JSON body
+func decode(t *testing.T, resp *http.Response, status int, v any) {
+	t.Helper()
+
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode != status {
+		t.Errorf("expected status %d, but got %d instead", status, resp.StatusCode)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,121 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+)
+
+// StarsMarker surrounds the stars table in the README
+const StarsMarker = "<!--- advent_readme_stars table --->"
+
+// ErrMissingMarkers is returned when the README doesn't contain the opening and closing markers of the stars table.
+var ErrMissingMarkers = errors.New("missing stars table markers")
+
+// DayStars describes the stars earned on a single day
+type DayStars struct {
+	Day   int
+	Stars [2]bool
+	// Runtime is the total run time of the solved parts, 0 if unknown
+	Runtime time.Duration
+}
+
+// CollectStars collects the stars of the year from the input summaries: a part earns a star if the answer of its
+// actual input is recorded. The missing second part of the last day, which is awarded for collecting every other
+// star of the event, earns a star once all the other parts of the 25 days have one.
+// The runtimes are summed from the results if any are provided. Days without any star are left out.
+func CollectStars(year int, infos []InputInfo, results []Result) []DayStars {
+	var days []DayStars
+	complete := true
+	last := -1
+	for _, info := range infos {
+		if info.Year != year {
+			continue
+		}
+		d := DayStars{Day: info.Day}
+		for _, part := range info.Recorded {
+			d.Stars[part-1] = true
+		}
+		for _, r := range results {
+			if r.Year == year && r.Day == info.Day && r.Err == nil {
+				d.Runtime += r.Duration
+			}
+		}
+
+		complete = complete && len(info.Recorded) == len(info.Parts)
+		if info.Day == 25 && len(info.Parts) == 1 {
+			last = len(days)
+		}
+		days = append(days, d)
+	}
+
+	if last >= 0 && complete && len(days) == 25 {
+		days[last].Stars[1] = true
+	}
+	return slices.DeleteFunc(days, func(d DayStars) bool {
+		return !d.Stars[0] && !d.Stars[1]
+	})
+}
+
+// RenderStars renders the stars table of the year in the format of the README, optionally with a runtime column.
+func RenderStars(year int, days []DayStars, runtime bool) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("## %d Results\n\n", year))
+	if runtime {
+		sb.WriteString("| Day | Part 1 | Part 2 | Runtime |\n| :---: | :---: | :---: | :---: |\n")
+	} else {
+		sb.WriteString("| Day | Part 1 | Part 2 |\n| :---: | :---: | :---: |\n")
+	}
+	for _, d := range days {
+		sb.WriteString(fmt.Sprintf("| [Day %d](https://adventofcode.com/%d/day/%d) | %s | %s |", d.Day, year, d.Day, star(d.Stars[0]), star(d.Stars[1])))
+		if runtime {
+			sb.WriteString(fmt.Sprintf(" %v |", round(d.Runtime)))
+		}
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+// ReplaceStars replaces the text between the stars table markers of the README content with the table.
+// Everything outside the markers is kept as it is.
+func ReplaceStars(content string, table string) (string, error) {
+	start := strings.Index(content, StarsMarker)
+	if start < 0 {
+		return "", ErrMissingMarkers
+	}
+	start += len(StarsMarker)
+	end := strings.Index(content[start:], StarsMarker)
+	if end < 0 {
+		return "", ErrMissingMarkers
+	}
+	return content[:start] + "\n" + table + content[start+end:], nil
+}
+
+// UpdateReadme writes the stars table into the README at the given path.
+// The file is only rewritten if the table changed, and the returned flag reports whether it did.
+func UpdateReadme(path string, table string) (bool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return false, err
+	}
+
+	content, err := ReplaceStars(string(data), table)
+	if err != nil {
+		return false, fmt.Errorf("%w in %s", err, path)
+	}
+	if content == string(data) {
+		return false, nil
+	}
+	return true, os.WriteFile(path, []byte(content), 0o644)
+}
+
+// star renders the cell of a part
+func star(earned bool) string {
+	if earned {
+		return "⭐"
+	}
+	return " "
+}
@@ -0,0 +1,94 @@
+package internal_test
+
+import (
+	"errors"
+	"github.com/wlchs/advent_of_code_go_template/internal"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// allSolved creates the input summaries of a fully solved year
+func allSolved(year int) []internal.InputInfo {
+	var infos []internal.InputInfo
+	for day := 1; day <= 25; day++ {
+		parts := []int{1, 2}
+		if day == 25 {
+			parts = []int{1}
+		}
+		infos = append(infos, internal.InputInfo{Year: year, Day: day, Parts: parts, Recorded: parts})
+	}
+	return infos
+}
+
+func TestCollectStars(t *testing.T) {
+	t.Parallel()
+
+	days := internal.CollectStars(2023, allSolved(2023), nil)
+	if len(days) != 25 || days[24].Stars != [2]bool{true, true} {
+		t.Errorf("expected every star of the year, but got %+v instead", days)
+	}
+
+	infos := allSolved(2023)
+	infos[2].Recorded = []int{1}
+	infos[3].Recorded = nil
+	results := []internal.Result{
+		{Year: 2023, Day: 1, Part: 1, Duration: time.Millisecond},
+		{Year: 2023, Day: 1, Part: 2, Duration: 2 * time.Millisecond},
+		{Year: 2023, Day: 2, Part: 1, Duration: time.Second, Err: errors.New("failed")},
+	}
+	days = internal.CollectStars(2023, infos, results)
+	if len(days) != 24 || days[2].Stars != [2]bool{true, false} || days[3].Day != 5 {
+		t.Errorf("expected the fourth day to be left out and a single star on the third, but got %+v instead", days)
+	}
+	if days[23].Stars != [2]bool{true, false} {
+		t.Errorf("expected the second star of the last day to be missing, but got %+v instead", days[23])
+	}
+	if days[0].Runtime != 3*time.Millisecond || days[1].Runtime != 0 {
+		t.Errorf("unexpected runtimes %v and %v", days[0].Runtime, days[1].Runtime)
+	}
+}
+
+func TestUpdateReadme(t *testing.T) {
+	t.Parallel()
+
+	data, err := os.ReadFile("../README.MD")
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "README.MD")
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	// the table of the fully solved year matches the one of the README
+	table := internal.RenderStars(2023, internal.CollectStars(2023, allSolved(2023), nil), false)
+	if changed, err := internal.UpdateReadme(path, table); err != nil || changed {
+		t.Errorf("expected the README to be unchanged, but got %t, %v instead", changed, err)
+	}
+
+	table = internal.RenderStars(2023, []internal.DayStars{{Day: 1, Stars: [2]bool{true, false}, Runtime: time.Millisecond}}, true)
+	if changed, err := internal.UpdateReadme(path, table); err != nil || !changed {
+		t.Fatalf("expected the README to change, but got %t, %v instead", changed, err)
+	}
+	updated, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := internal.StarsMarker + "\n## 2023 Results\n\n| Day | Part 1 | Part 2 | Runtime |\n| :---: | :---: | :---: | :---: |\n" +
+		"| [Day 1](https://adventofcode.com/2023/day/1) | ⭐ |   | 1ms |\n" + internal.StarsMarker
+	if !strings.Contains(string(updated), expected) {
+		t.Errorf("expected the table\n%s\nin the README, but got\n%s\ninstead", expected, updated)
+	}
+	before := string(data[:strings.Index(string(data), internal.StarsMarker)])
+	after := string(data[strings.LastIndex(string(data), internal.StarsMarker):])
+	if !strings.HasPrefix(string(updated), before) || !strings.HasSuffix(string(updated), after) {
+		t.Error("expected the text outside the markers to be unchanged")
+	}
+
+	if _, err := internal.ReplaceStars("no markers", table); !errors.Is(err, internal.ErrMissingMarkers) {
+		t.Errorf("expected ErrMissingMarkers, but got %v instead", err)
+	}
+}
@@ -0,0 +1,148 @@
+package watch
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/wlchs/advent_of_code_go_template/internal"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// ErrBuildFailed is returned when the child process doesn't produce any results, e.g. because of a compilation error.
+var ErrBuildFailed = errors.New("build failed")
+
+// fileState is the polled state of a watched file
+type fileState struct {
+	modTime time.Time
+	size    int64
+}
+
+// Watch polls the files at the given paths in every interval until the context is done, and calls onChange with the
+// paths that were modified, created or removed since the previous poll. The files of a directory are watched
+// non-recursively, and paths that don't exist yet are picked up once they are created.
+func Watch(ctx context.Context, paths []string, interval time.Duration, onChange func(changed []string)) error {
+	prev := snapshot(paths)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			cur := snapshot(paths)
+			if changed := diff(prev, cur); len(changed) > 0 {
+				onChange(changed)
+			}
+			prev = cur
+		}
+	}
+}
+
+// snapshot records the state of the files at the given paths
+func snapshot(paths []string) map[string]fileState {
+	files := map[string]fileState{}
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			continue
+		}
+		if !info.IsDir() {
+			files[path] = fileState{modTime: info.ModTime(), size: info.Size()}
+			continue
+		}
+
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			continue
+		}
+		for _, e := range entries {
+			if info, err := e.Info(); err == nil && !e.IsDir() {
+				files[filepath.Join(path, e.Name())] = fileState{modTime: info.ModTime(), size: info.Size()}
+			}
+		}
+	}
+	return files
+}
+
+// diff lists the paths that differ between the snapshots in alphabetical order
+func diff(prev map[string]fileState, cur map[string]fileState) []string {
+	var changed []string
+	for path, state := range cur {
+		if p, ok := prev[path]; !ok || p != state {
+			changed = append(changed, path)
+		}
+	}
+	for path := range prev {
+		if _, ok := cur[path]; !ok {
+			changed = append(changed, path)
+		}
+	}
+	sort.Strings(changed)
+	return changed
+}
+
+// Run rebuilds and runs the binary with `go run` in a child process from the project root, and decodes the results
+// it prints in the JSON format. The arguments shouldn't select an output format. The compiler's messages are returned
+// with ErrBuildFailed if the child process doesn't print any results.
+func Run(ctx context.Context, root string, args []string) ([]internal.Result, error) {
+	cmd := exec.CommandContext(ctx, "go", append([]string{"run", "."}, append(args, "--output", "json")...)...)
+	cmd.Dir = root
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	// the child exits with a non-zero code if any of the parts failed, its results tell why
+	runErr := cmd.Run()
+	results, err := internal.ReadResults(&stdout)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v\n%s", ErrBuildFailed, runErr, strings.TrimSpace(stderr.String()))
+	}
+	return results, nil
+}
+
+// PrintComparison writes the results as a table along with the answers and durations of the previous results of the
+// same parts, so that the effect of a change is visible at a glance.
+func PrintComparison(w io.Writer, results []internal.Result, previous []internal.Result) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "DAY\tPART\tANSWER\tDURATION\tSTATUS\tPREVIOUS ANSWER\tPREVIOUS DURATION")
+	for _, r := range results {
+		prevAnswer, prevDuration := "-", "-"
+		for _, p := range previous {
+			if p.Year == r.Year && p.Day == r.Day && p.Part == r.Part && p.Err == nil {
+				prevAnswer = p.Answer
+				if p.Answer == r.Answer {
+					prevAnswer += " (same)"
+				}
+				prevDuration = fmt.Sprint(p.Duration.Round(time.Microsecond))
+			}
+		}
+		_, _ = fmt.Fprintf(tw, "%d\t%s\t%s\t%v\t%s\t%s\t%s\n",
+			r.Day, internal.PartLabel(r.Part), r.Answer, r.Duration.Round(time.Microsecond), r.Status(), prevAnswer, prevDuration)
+	}
+	return tw.Flush()
+}
+
+// Remember merges the successful results into the previous ones, replacing the earlier results of the same parts.
+// Failed parts keep their last successful result for comparison.
+func Remember(previous []internal.Result, results []internal.Result) []internal.Result {
+	for _, r := range results {
+		if r.Err != nil {
+			continue
+		}
+		previous = slices.DeleteFunc(previous, func(p internal.Result) bool {
+			return p.Year == r.Year && p.Day == r.Day && p.Part == r.Part
+		})
+		previous = append(previous, r)
+	}
+	return previous
+}
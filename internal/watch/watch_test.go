@@ -0,0 +1,83 @@
+package watch_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/wlchs/advent_of_code_go_template/internal"
+	"github.com/wlchs/advent_of_code_go_template/internal/watch"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWatch(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	source := filepath.Join(dir, "main.go")
+	input := filepath.Join(t.TempDir(), "day_01.txt")
+	if err := os.WriteFile(source, []byte("package day_01"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	changes := make(chan []string, 10)
+	go func() {
+		_ = watch.Watch(ctx, []string{dir, input}, 10*time.Millisecond, func(changed []string) {
+			changes <- changed
+		})
+	}()
+
+	// give the watcher time to take its first snapshot
+	time.Sleep(50 * time.Millisecond)
+	if err := os.WriteFile(source, []byte("package day_01 // changed"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(input, []byte("1abc2"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	seen := map[string]bool{}
+	for !seen[source] || !seen[input] {
+		select {
+		case changed := <-changes:
+			for _, path := range changed {
+				seen[path] = true
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("expected changes of %s and %s, but got %v instead", source, input, seen)
+		}
+	}
+}
+
+func TestPrintComparison(t *testing.T) {
+	t.Parallel()
+
+	previous := []internal.Result{
+		{Year: 2023, Day: 1, Part: 1, Answer: "142", Duration: time.Millisecond},
+		{Year: 2023, Day: 1, Part: 2, Answer: "281", Duration: time.Millisecond},
+	}
+	results := []internal.Result{
+		{Year: 2023, Day: 1, Part: 1, Answer: "142", Duration: 2 * time.Millisecond},
+		{Year: 2023, Day: 1, Part: 2, Err: errors.New("panic: oops")},
+	}
+
+	var buf bytes.Buffer
+	if err := watch.PrintComparison(&buf, results, previous); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 || !strings.Contains(lines[1], "142 (same)") || !strings.Contains(lines[2], "panic: oops") || !strings.Contains(lines[2], "281") {
+		t.Errorf("unexpected comparison\n%s", buf.String())
+	}
+
+	// the failed part keeps its last successful result
+	previous = watch.Remember(previous, results)
+	if len(previous) != 2 || previous[0].Answer != "281" || previous[1].Duration != 2*time.Millisecond {
+		t.Errorf("unexpected previous results %+v", previous)
+	}
+}
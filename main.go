@@ -1,43 +1,786 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"github.com/wlchs/advent_of_code_go_template/internal"
+	"github.com/wlchs/advent_of_code_go_template/internal/client"
+	"github.com/wlchs/advent_of_code_go_template/internal/config"
+	"github.com/wlchs/advent_of_code_go_template/internal/registry"
+	"github.com/wlchs/advent_of_code_go_template/internal/scaffold"
+	"github.com/wlchs/advent_of_code_go_template/internal/server"
+	"github.com/wlchs/advent_of_code_go_template/internal/watch"
+	_ "github.com/wlchs/advent_of_code_go_template/years"
+	"io"
+	"io/fs"
+	"net/http"
 	"os"
-	"strconv"
+	"os/signal"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"text/tabwriter"
+	"time"
 )
 
+// exit codes of the process
+const (
+	exitFailure      = 1 // a solver failed or an unexpected error occurred
+	exitUsage        = 2 // the command line arguments are invalid
+	exitInputMissing = 3 // an input file doesn't exist
+	exitInputInvalid = 4 // an input file couldn't be read or decompressed
+	exitNoSolver     = 5 // no solver is registered for a selected day
+	exitMismatch     = 6 // an answer differs from the recorded one
+	exitTimeout      = 7 // a solver timed out or was interrupted
+)
+
+// command is a subcommand of the binary
+type command struct {
+	name string
+	// args is the synopsis of the arguments shown in the usage
+	args    string
+	summary string
+	run     func(flags *flag.FlagSet, args []string)
+}
+
+// commands lists the subcommands in the order of the usage output
+func commands() []command {
+	return []command{
+		{name: "run", args: "--day <days> [flags]", summary: "solve the selected days and print the answers", run: runDays},
+		{name: "verify", args: "--day <days> [flags]", summary: "solve the selected days and compare the answers with the recorded ones", run: verifyDays},
+		{name: "bench", args: "--day <days> [flags]", summary: "benchmark the selected days and compare them with the baseline", run: benchDays},
+		{name: "list", args: "[flags]", summary: "list the inputs, recorded answers and examples of every day", run: listInputs},
+		{name: "new", args: "--day <day> [flags]", summary: "generate the package of a new day", run: newDay},
+		{name: "watch", args: "--day <day> [flags]", summary: "rerun a day whenever its sources or input change", run: watchDay},
+		{name: "fetch", args: "--day <days> [flags]", summary: "download the inputs from the puzzle site", run: fetch},
+		{name: "submit", args: "--day <day> --part <part> [flags]", summary: "submit an answer to the puzzle site", run: submit},
+		{name: "stars", args: "[flags]", summary: "regenerate the stars table of the README", run: stars},
+		{name: "serve", args: "[flags]", summary: "expose the solvers over HTTP", run: serve},
+		{name: "config", args: "show [flags]", summary: "print the resolved settings and their sources", run: showConfig},
+		{name: "cache", args: "clear [flags]", summary: "remove the answer cache", run: clearCache},
+	}
+}
+
 // main entry point
-// The --day parameter is required to choose which daily challenge should be executed.
-// The --input parameter is also required, and it points to the input file that should be used for the challenge.
-// The --mode parameter specifies which part of the challenge should be executed:
-// - 1: only the first part
-// - 2: only the second part
-// - 3 or empty: both parts
+// The first argument selects the subcommand, see commands for the list. Each subcommand parses its own parameters,
+// and prints its usage with -h. Invalid parameters are reported along with the usage, and the process exits with
+// exitUsage.
+// The run, verify and bench subcommands share the parameters selecting the days, see selectionFlags.
+// The --config parameter points to a TOML configuration file, which defaults to $AOC_CONFIG or aoc.toml. It sets the
+// inputs directory, the year, the output format, the timeout, the number of jobs, and the input, timeout and parameters
+// of specific days. The parameters take precedence over the environment variables, which take precedence over the file.
 func main() {
-	d := flag.String("day", "", "day ID to execute")
-	i := flag.String("input", "", "input file path")
-	m := flag.String("mode", "3", "running mode")
-	flag.Parse()
+	if len(os.Args) < 2 {
+		usage(os.Stderr)
+		os.Exit(exitUsage)
+	}
+
+	name := os.Args[1]
+	if name == "help" || name == "-h" || name == "--help" {
+		usage(os.Stdout)
+		return
+	}
+	for _, cmd := range commands() {
+		if cmd.name == name {
+			cmd.run(newFlagSet(cmd), os.Args[2:])
+			return
+		}
+	}
+
+	fmt.Fprintf(os.Stderr, "unknown command %q\n\n", name)
+	usage(os.Stderr)
+	os.Exit(exitUsage)
+}
+
+// usage writes the list of subcommands
+func usage(w io.Writer) {
+	program := filepath.Base(os.Args[0])
+	_, _ = fmt.Fprintf(w, "usage: %s <command> [flags]\n\ncommands:\n", program)
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for _, cmd := range commands() {
+		_, _ = fmt.Fprintf(tw, "  %s\t%s\n", cmd.name, cmd.summary)
+	}
+	_ = tw.Flush()
+	_, _ = fmt.Fprintf(w, "\nRun \"%s <command> -h\" for the flags of a command.\n", program)
+}
+
+// newFlagSet creates the parameter set of the subcommand along with its usage output
+func newFlagSet(cmd command) *flag.FlagSet {
+	flags := flag.NewFlagSet(cmd.name, flag.ExitOnError)
+	flags.Usage = func() {
+		_, _ = fmt.Fprintf(flags.Output(), "usage: %s %s %s\n\n%s\n\nflags:\n", filepath.Base(os.Args[0]), cmd.name, cmd.args, cmd.summary)
+		flags.PrintDefaults()
+	}
+	return flags
+}
+
+// usageError reports invalid parameters along with the usage of the subcommand, and exits with exitUsage
+func usageError(flags *flag.FlagSet, err error) {
+	_, _ = fmt.Fprintf(flags.Output(), "%s\n\n", err)
+	flags.Usage()
+	os.Exit(exitUsage)
+}
+
+// options are the parsed command line parameters
+type options struct {
+	year      int
+	days      []int
+	parts     []int
+	inputPath string
+	inputDir  string
+	format    internal.Format
+	timeout   time.Duration
+	jobs      int
+	profile   internal.Profile
+	overrides map[int]internal.DayOptions
+	cache     bool
+}
+
+// runOptions gives back the execution parameters of the challenges
+func (o options) runOptions() internal.RunOptions {
+	return internal.RunOptions{Parts: o.parts, Timeout: o.timeout, Jobs: o.jobs, Profile: o.profile, Days: o.overrides}
+}
+
+// singleDay gives back the selected day if a single day is solved with an explicitly provided input file
+func (o options) singleDay() (int, bool) {
+	return o.days[0], o.inputPath != ""
+}
+
+// selectionFlags registers the parameters shared by the run, verify and bench subcommands, along with --config.
+// The --year parameter selects the event, it defaults to the most recent year having solvers.
+// The --day parameter is required: a single day, "all" or a list of days and day ranges such as "1-10,17".
+// The --part parameter selects the parts: 1, 2, "1,2" or "all". Selected parts a day doesn't have, such as the second
+// part of day 25, are reported as not applicable.
+// The --input parameter points to the input file of a single day; use "-" to read the standard input, and files
+// ending with .gz or .zst are decompressed. Otherwise, the inputs are loaded from the inputs directory, following
+// the inputs/<year>/day_05.txt naming convention. The inputs directory is set by --inputs, or by the AOC_INPUTS_DIR
+// environment variable, and defaults to "inputs".
+// The --timeout parameter limits the run time of each part, such as 30s or 1m; parts exceeding it are reported as
+// timed out. Interrupting the process with Ctrl+C cancels the running part the same way.
+// The --cpuprofile, --memprofile, --trace and --blockprofile parameters record the profiles of each solved part, or of
+// all runs of a benchmarked part. The file names get the year, day and part appended, e.g. cpu_2023_day_16_part_1.pprof,
+// and the files are written to --profile-dir, which defaults to the working directory. Profiling requires --jobs 1.
+// The returned function validates the parameters once they are parsed.
+func selectionFlags(flags *flag.FlagSet) func() options {
+	var opts options
+	settings := configFlag(flags)
+	flags.Int("year", 0, "year of the event, defaults to the most recent year with solvers")
+	day := flags.String("day", "", "days to execute: a day, \"all\" or a list of days such as 1-10,17")
+	part := flags.String("part", "all", "parts to execute: 1, 2, 1,2 or all")
+	flags.StringVar(&opts.inputPath, "input", "", "input file path of a single day, - reads the standard input")
+	flags.String("inputs", "", "inputs directory, defaults to $"+internal.InputsDirEnv+" or inputs")
+	flags.Duration("timeout", 0, "maximum run time of each part, such as 30s, 0 means no limit")
+	flags.StringVar(&opts.profile.CPU, "cpuprofile", "", "write a CPU profile of each part, e.g. cpu.pprof")
+	flags.StringVar(&opts.profile.Mem, "memprofile", "", "write a memory profile of each part, e.g. mem.pprof")
+	flags.StringVar(&opts.profile.Trace, "trace", "", "write an execution trace of each part, e.g. trace.out")
+	flags.StringVar(&opts.profile.Block, "blockprofile", "", "write a blocking profile of each part, e.g. block.pprof")
+	flags.StringVar(&opts.profile.Dir, "profile-dir", "", "directory of the profiles, defaults to the working directory")
+
+	return func() options {
+		if flags.NArg() > 0 {
+			usageError(flags, fmt.Errorf("unexpected arguments: %s", strings.Join(flags.Args(), " ")))
+		}
+		if *day == "" {
+			usageError(flags, errors.New("missing --day"))
+		}
+
+		s := settings()
+		opts.year = s.Year
+		opts.inputDir = s.Inputs
+		opts.format = s.Output
+		opts.timeout = s.Timeout
+		opts.jobs = s.Jobs
+		opts.overrides = s.Days[opts.year]
+		opts.cache = s.Cache
+
+		var err error
+		if opts.days, err = internal.ParseDays(opts.year, *day); err != nil {
+			usageError(flags, err)
+		}
+		if len(opts.days) == 0 {
+			usageError(flags, fmt.Errorf("%w: no solvers registered for %d", internal.ErrInvalidDaySelection, opts.year))
+		}
+		if opts.parts, err = internal.ParseParts(*part); err != nil {
+			usageError(flags, err)
+		}
+		if opts.inputPath != "" && len(opts.days) != 1 {
+			usageError(flags, errors.New("--input requires a single day"))
+		}
+		return opts
+	}
+}
+
+// outputFlags registers the parameters of the run and verify subcommands printing the answers.
+// The --output parameter selects the format of the results: a text table, json, csv or tap.
+// The --jobs parameter solves the given number of days concurrently, 0 uses one worker per CPU. The results are
+// printed in the order of the days either way.
+// The --no-cache parameter bypasses the answer cache enabled by the configuration file or $AOC_CACHE. The cache keeps
+// the answers of the parts solved by the same build for the same input, and the cached answers are marked as such.
+// The returned function validates them along with the selection.
+func outputFlags(flags *flag.FlagSet, selection func() options) func() options {
+	flags.String("output", "text", "output format: text, json, csv or tap")
+	flags.Int("jobs", 1, "number of days solved concurrently, 0 means one per CPU")
+	flags.Bool("no-cache", false, "solve every part even if the answer cache is enabled")
+	return func() options {
+		opts := selection()
+		if opts.jobs == 0 {
+			opts.jobs = runtime.NumCPU()
+		}
+		if opts.profile.Enabled() && opts.jobs > 1 {
+			usageError(flags, errors.New("profiling requires --jobs 1"))
+		}
+		return opts
+	}
+}
+
+// runDays executes the selected days and prints the results in the selected format, see selectionFlags and
+// outputFlags for its parameters.
+// The process exits with a non-zero code if any of the days failed.
+func runDays(flags *flag.FlagSet, args []string) {
+	parse := outputFlags(flags, selectionFlags(flags))
+	_ = flags.Parse(args)
+	opts := parse()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	exitOnFailure(printResults(opts, solve(ctx, opts)))
+}
+
+// verifyDays executes the selected days like runDays, and compares the answers with the ones recorded in the
+// --answers file, which defaults to answers.json in the inputs directory. Changed answers fail with exitMismatch.
+// With --record, new and changed answers are written to the answers file instead.
+func verifyDays(flags *flag.FlagSet, args []string) {
+	parse := outputFlags(flags, selectionFlags(flags))
+	record := flags.Bool("record", false, "record new and changed answers")
+	answers := flags.String("answers", "", "answers file path, defaults to answers.json in the inputs directory")
+	_ = flags.Parse(args)
+	opts := parse()
+	if *answers == "" {
+		*answers = internal.AnswersPath(opts.inputDir)
+	}
 
-	if d == nil || i == nil {
-		fmt.Println("missing required input params")
-		os.Exit(1)
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	results := solve(ctx, opts)
+	verifyResults(results, *record, *answers)
+	exitOnFailure(printResults(opts, results))
+}
+
+// solve executes the selected days. A single day is solved with the given input file if provided, otherwise the
+// inputs are loaded from the inputs directory. The answers are taken from and added to the answer cache if it's enabled.
+func solve(ctx context.Context, opts options) []internal.Result {
+	runOpts := opts.runOptions()
+	if opts.cache {
+		runOpts.Cache = loadCache(opts.inputDir)
+	}
+
+	var results []internal.Result
+	if day, ok := opts.singleDay(); ok {
+		results = internal.RunChallenge(ctx, opts.year, day, opts.inputPath, runOpts)
+	} else {
+		results = internal.RunChallenges(ctx, opts.year, opts.days, opts.inputDir, runOpts)
 	}
 
-	day, err := strconv.Atoi(*d)
+	if runOpts.Cache != nil {
+		if err := runOpts.Cache.Save(); err != nil {
+			fmt.Println(err)
+			os.Exit(exitFailure)
+		}
+	}
+	return results
+}
+
+// loadCache loads the answer cache of the running build from the inputs directory
+func loadCache(inputDir string) *internal.AnswerCache {
+	buildID, err := internal.BuildID()
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(exitFailure)
+	}
+	cache, err := internal.LoadCache(internal.CachePath(inputDir), buildID)
 	if err != nil {
-		fmt.Println("couldn't parse day")
-		os.Exit(1)
+		fmt.Println(err)
+		os.Exit(exitFailure)
+	}
+	return cache
+}
+
+// printResults writes the results in the selected format
+func printResults(opts options, results []internal.Result) []internal.Result {
+	if err := internal.WriteResults(os.Stdout, opts.format, results); err != nil {
+		fmt.Println(err)
+		os.Exit(exitFailure)
+	}
+	return results
+}
+
+// exitOnFailure exits with the code of the first failed result, if any
+func exitOnFailure(results []internal.Result) {
+	for _, r := range results {
+		if r.Err != nil {
+			os.Exit(exitCode(r.Err))
+		}
+	}
+}
+
+// benchDays benchmarks the selected days, see selectionFlags for the shared parameters.
+// The --runs parameter sets how many times each selected part runs, and timing and allocation statistics are printed
+// instead of the answers. With --save-baseline, the statistics are written to the --baseline file; otherwise an
+// existing baseline is compared against, and medians that got slower by more than --threshold percent are reported as
+// regressions. Benchmarks always run one part at a time to keep the timings comparable.
+// The process exits with a non-zero code if any of the parts failed or regressed.
+func benchDays(flags *flag.FlagSet, args []string) {
+	parse := selectionFlags(flags)
+	runs := flags.Int("runs", 10, "number of benchmark runs per part")
+	baseline := flags.String("baseline", "bench_baseline.json", "benchmark baseline file path")
+	saveBaseline := flags.Bool("save-baseline", false, "save the benchmark results as the new baseline")
+	threshold := flags.Float64("threshold", 10, "allowed slowdown compared to the baseline in percent")
+	_ = flags.Parse(args)
+	opts := parse()
+	if *runs < 1 {
+		usageError(flags, fmt.Errorf("invalid number of runs %d", *runs))
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	var results []internal.BenchResult
+	if day, ok := opts.singleDay(); ok {
+		results = internal.BenchmarkChallenge(ctx, opts.year, day, opts.inputPath, opts.runOptions(), *runs)
+	} else {
+		results = internal.BenchmarkChallenges(ctx, opts.year, opts.days, opts.inputDir, opts.runOptions(), *runs)
 	}
 
-	mode, err := strconv.Atoi(*m)
+	if *saveBaseline {
+		if err := internal.SaveBaseline(*baseline, results); err != nil {
+			fmt.Println(err)
+			os.Exit(exitFailure)
+		}
+	} else if err := internal.CompareBaseline(*baseline, results); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		fmt.Println(err)
+		os.Exit(exitFailure)
+	}
+
+	if err := internal.PrintBenchSummary(os.Stdout, results, *threshold/100); err != nil {
+		fmt.Println(err)
+		os.Exit(exitFailure)
+	}
+
+	for _, r := range results {
+		if r.Err != nil {
+			os.Exit(exitCode(r.Err))
+		}
+		if r.Regressed(*threshold / 100) {
+			os.Exit(exitFailure)
+		}
+	}
+}
+
+// listInputs prints which days have inputs, recorded answers and examples.
+// The --inputs, --answers and --config parameters locate the inputs and the recorded answers like for verify.
+func listInputs(flags *flag.FlagSet, args []string) {
+	settings := configFlag(flags)
+	flags.String("inputs", "", "inputs directory, defaults to $"+internal.InputsDirEnv+" or inputs")
+	answers := flags.String("answers", "", "answers file path, defaults to answers.json in the inputs directory")
+	_ = flags.Parse(args)
+	if flags.NArg() > 0 {
+		usageError(flags, fmt.Errorf("unexpected arguments: %s", strings.Join(flags.Args(), " ")))
+	}
+
+	s := settings()
+	if *answers == "" {
+		*answers = internal.AnswersPath(s.Inputs)
+	}
+	store, err := internal.LoadAnswers(*answers)
 	if err != nil {
-		fmt.Println("incorrect mode")
-		os.Exit(1)
+		fmt.Println(err)
+		os.Exit(exitFailure)
 	}
 
-	inputPath := *i
-	internal.RunChallenge(day, inputPath, mode)
+	if err := internal.PrintInputs(os.Stdout, internal.ListInputs(s.Inputs, s.Days, store)); err != nil {
+		fmt.Println(err)
+		os.Exit(exitFailure)
+	}
+}
+
+// newDay generates the package of a new day from the templates and links it into the binary.
+// The --day parameter is required, --year defaults to the most recent year with solvers and --title sets the
+// title of the challenge.
+func newDay(flags *flag.FlagSet, args []string) {
+	d := flags.Int("day", 0, "day ID to generate")
+	y := flags.Int("year", registry.LatestYear(), "year of the event")
+	t := flags.String("title", "", "title of the challenge")
+	_ = flags.Parse(args)
+
+	dir, err := scaffold.Generate(scaffold.Options{Root: ".", Year: *y, Day: *d, Title: *t})
+	if errors.Is(err, scaffold.ErrInvalidDay) || errors.Is(err, scaffold.ErrInvalidYear) {
+		usageError(flags, err)
+	}
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(exitFailure)
+	}
+	fmt.Printf("created %s\n", dir)
+}
+
+// serve starts an HTTP server exposing the registered solvers until the process is interrupted.
+// The --addr parameter sets the listening address, --timeout limits the run time of each solved part and
+// --max-input limits the size of the posted inputs in bytes.
+func serve(flags *flag.FlagSet, args []string) {
+	addr := flags.String("addr", "localhost:8080", "listening address of the server")
+	timeout := flags.Duration("timeout", 30*time.Second, "maximum run time of each part, 0 means no limit")
+	maxInput := flags.Int64("max-input", server.DefaultMaxInputBytes, "maximum size of the posted inputs in bytes")
+	_ = flags.Parse(args)
+
+	srv := &http.Server{
+		Addr:              *addr,
+		Handler:           server.New(server.Options{MaxInputBytes: *maxInput, Timeout: *timeout}),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = srv.Shutdown(shutdownCtx)
+	}()
+
+	fmt.Printf("listening on %s\n", *addr)
+	if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		fmt.Println(err)
+		stop()
+		os.Exit(exitFailure)
+	}
+}
+
+// watchDay reruns a single day in a child process whenever a file of its package or its input changes, and prints the
+// new results next to the previous ones. The --day parameter is required; --year, --part, --input, --inputs,
+// --timeout and --config work like for the runs, and --interval sets how often the files are polled.
+func watchDay(flags *flag.FlagSet, args []string) {
+	settings := configFlag(flags)
+	flags.Int("year", 0, "year of the event, defaults to the most recent year with solvers")
+	day := flags.Int("day", 0, "day ID to watch")
+	part := flags.String("part", "all", "parts to execute: 1, 2, 1,2 or all")
+	inputPath := flags.String("input", "", "input file path, defaults to the day's input in the inputs directory")
+	flags.String("inputs", "", "inputs directory, defaults to $"+internal.InputsDirEnv+" or inputs")
+	flags.Duration("timeout", 0, "maximum run time of each part, such as 30s, 0 means no limit")
+	interval := flags.Duration("interval", 500*time.Millisecond, "polling interval of the watched files")
+	_ = flags.Parse(args)
+
+	s := settings()
+	if *day == 0 || *interval <= 0 {
+		usageError(flags, errors.New("missing or incorrect day and interval"))
+	}
+	if _, err := internal.ParseParts(*part); err != nil {
+		usageError(flags, err)
+	}
+	if _, err := registry.Lookup(s.Year, *day); err != nil {
+		fmt.Println(err)
+		os.Exit(exitCode(err))
+	}
+	if *inputPath == "" {
+		var exists bool
+		*inputPath, exists = internal.ResolveInput(s.Inputs, s.Year, *day, s.Days[s.Year])
+		if !exists {
+			err := &internal.InputError{Path: *inputPath, Err: fs.ErrNotExist}
+			fmt.Println(err)
+			os.Exit(exitCode(err))
+		}
+	}
+	childArgs := []string{"run", "--year", fmt.Sprint(s.Year), "--day", fmt.Sprint(*day), "--part", *part, "--input", *inputPath, "--timeout", s.Timeout.String()}
+	if s.Path != "" {
+		childArgs = append(childArgs, "--config", s.Path)
+	}
+	paths := []string{internal.DayDir(s.Year, *day), *inputPath}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	var previous []internal.Result
+	rerun := func() {
+		results, err := watch.Run(ctx, ".", childArgs)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		_ = watch.PrintComparison(os.Stdout, results, previous)
+		previous = watch.Remember(previous, results)
+	}
+
+	fmt.Printf("watching %s\n", strings.Join(paths, ", "))
+	rerun()
+	_ = watch.Watch(ctx, paths, *interval, func(changed []string) {
+		fmt.Printf("\n%s changed: %s\n", time.Now().Format(time.TimeOnly), strings.Join(changed, ", "))
+		rerun()
+	})
+}
+
+// stars regenerates the stars table between the markers of the README from the recorded answers.
+// The --year parameter defaults to the most recent year with solvers, --readme points to the README file, and
+// --inputs, --answers and --config locate the inputs and the recorded answers like for verify. With --runtime, the
+// days are solved to add a runtime column to the table.
+func stars(flags *flag.FlagSet, args []string) {
+	settings := configFlag(flags)
+	flags.Int("year", 0, "year of the event, defaults to the most recent year with solvers")
+	readme := flags.String("readme", "README.MD", "README file containing the stars table markers")
+	flags.String("inputs", "", "inputs directory, defaults to $"+internal.InputsDirEnv+" or inputs")
+	answers := flags.String("answers", "", "answers file path, defaults to answers.json in the inputs directory")
+	withRuntime := flags.Bool("runtime", false, "add a runtime column by solving every day")
+	_ = flags.Parse(args)
+
+	s := settings()
+	days, err := internal.ParseDays(s.Year, "all")
+	if err != nil {
+		usageError(flags, err)
+	}
+	if len(days) == 0 {
+		usageError(flags, fmt.Errorf("%w: no solvers registered for %d", internal.ErrInvalidDaySelection, s.Year))
+	}
+	if *answers == "" {
+		*answers = internal.AnswersPath(s.Inputs)
+	}
+	store, err := internal.LoadAnswers(*answers)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(exitFailure)
+	}
+
+	var results []internal.Result
+	if *withRuntime {
+		opts := internal.RunOptions{Timeout: s.Timeout, Jobs: s.Jobs, Days: s.Days[s.Year]}
+		results = internal.RunChallenges(context.Background(), s.Year, days, s.Inputs, opts)
+	}
+
+	collected := internal.CollectStars(s.Year, internal.ListInputs(s.Inputs, s.Days, store), results)
+	changed, err := internal.UpdateReadme(*readme, internal.RenderStars(s.Year, collected, *withRuntime))
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(exitFailure)
+	}
+	if changed {
+		fmt.Printf("updated the stars table of %s\n", *readme)
+	} else {
+		fmt.Printf("the stars table of %s is up to date\n", *readme)
+	}
+}
+
+// siteFlags registers the parameters of the puzzle site shared by the fetch and submit subcommands.
+// The returned function resolves the settings and creates the client once the parameters are parsed.
+func siteFlags(flags *flag.FlagSet) func() (*client.Client, config.Settings) {
+	settings := configFlag(flags)
+	flags.Int("year", 0, "year of the event, defaults to the most recent year with solvers")
+	flags.String("inputs", "", "inputs directory, defaults to $"+internal.InputsDirEnv+" or inputs")
+	session := flags.String("session", "", "session cookie of the puzzle site, defaults to $"+client.SessionEnv)
+	baseURL := flags.String("base-url", "", "address of the puzzle site, defaults to $"+client.BaseURLEnv+" or "+client.DefaultBaseURL)
+	return func() (*client.Client, config.Settings) {
+		s := settings()
+		if *session == "" {
+			*session = os.Getenv(client.SessionEnv)
+		}
+		if *baseURL == "" {
+			*baseURL = os.Getenv(client.BaseURLEnv)
+		}
+		return client.New(client.Options{
+			BaseURL:          *baseURL,
+			Session:          *session,
+			WrongAnswersPath: client.WrongAnswersPath(s.Inputs),
+		}), s
+	}
+}
+
+// fetch downloads the inputs of the selected days into the inputs directory, existing inputs are kept.
+// The --day parameter is required, --year defaults to the most recent year with solvers.
+// The --session, --base-url, --inputs and --config parameters configure the puzzle site and the inputs directory.
+func fetch(flags *flag.FlagSet, args []string) {
+	d := flags.String("day", "", "day ID to download, \"all\" or a list of days such as 1-10,17")
+	newClient := siteFlags(flags)
+	_ = flags.Parse(args)
+
+	c, s := newClient()
+	days, err := internal.ParseDays(s.Year, *d)
+	if err != nil {
+		usageError(flags, err)
+	}
+
+	for _, day := range days {
+		path, err := c.DownloadInput(context.Background(), s.Inputs, s.Year, day)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(exitFailure)
+		}
+		fmt.Println(path)
+	}
+}
+
+// submit sends the answer of a part to the puzzle site and prints its verdict.
+// The --day and --part parameters are required, --year defaults to the most recent year with solvers.
+// Without --answer, the part is solved with the day's input selected like for the runs, and an accepted answer is
+// recorded in the answers file. Answers known to be wrong are never submitted again. The process exits with a non-zero
+// code unless the answer is accepted.
+func submit(flags *flag.FlagSet, args []string) {
+	day := flags.Int("day", 0, "day ID of the answer")
+	part := flags.Int("part", 0, "part of the answer, 1 or 2")
+	answer := flags.String("answer", "", "answer to submit, defaults to the solver's answer of the actual input")
+	newClient := siteFlags(flags)
+	_ = flags.Parse(args)
+
+	if *day < 1 || *day > 25 || (*part != 1 && *part != 2) {
+		usageError(flags, errors.New("missing or incorrect day and part"))
+	}
+	c, s := newClient()
+
+	var solved *internal.Result
+	if *answer == "" {
+		opts := internal.RunOptions{Parts: []int{*part}, Timeout: s.Timeout, Days: s.Days[s.Year]}
+		results := internal.RunChallenges(context.Background(), s.Year, []int{*day}, s.Inputs, opts)
+		if results[0].NotApplicable {
+			fmt.Printf("%d day %d has no part %d\n", s.Year, *day, *part)
+			os.Exit(exitUsage)
+		}
+		if results[0].Err != nil {
+			_ = internal.PrintSummary(os.Stdout, results)
+			os.Exit(exitCode(results[0].Err))
+		}
+		solved = &results[0]
+		*answer = solved.Answer
+	}
+
+	v, err := c.Submit(context.Background(), s.Year, *day, *part, *answer)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(exitFailure)
+	}
+	fmt.Printf("%d day %d part %d: %s is %s\n", s.Year, *day, *part, *answer, v)
+	if v.Message != "" {
+		fmt.Println(v.Message)
+	}
+	if v.Outcome != client.Accepted {
+		os.Exit(exitFailure)
+	}
+
+	if solved != nil {
+		verifyResults([]internal.Result{*solved}, true, internal.AnswersPath(s.Inputs))
+	}
+}
+
+// showConfig prints the resolved settings and where each of them comes from.
+// The "show" action is the only one; --config and the parameters of the runs override the settings like for the runs.
+func showConfig(flags *flag.FlagSet, args []string) {
+	settings := configFlag(flags)
+	flags.Int("year", 0, "year of the event, defaults to the most recent year with solvers")
+	flags.String("inputs", "", "inputs directory, defaults to $"+internal.InputsDirEnv+" or inputs")
+	flags.String("output", "text", "output format: text, json, csv or tap")
+	flags.Duration("timeout", 0, "maximum run time of each part, such as 30s, 0 means no limit")
+	flags.Int("jobs", 1, "number of days solved concurrently, 0 means one per CPU")
+	flags.Bool("no-cache", false, "solve every part even if the answer cache is enabled")
+	if len(args) == 0 || args[0] != "show" {
+		_ = flags.Parse(args)
+		usageError(flags, errors.New("missing or unknown action, expected show"))
+	}
+	_ = flags.Parse(args[1:])
+
+	if err := config.Print(os.Stdout, settings()); err != nil {
+		fmt.Println(err)
+		os.Exit(exitFailure)
+	}
+}
+
+// clearCache removes the answer cache from the inputs directory.
+// The "clear" action is the only one; --inputs and --config locate the inputs directory like for the runs.
+func clearCache(flags *flag.FlagSet, args []string) {
+	settings := configFlag(flags)
+	flags.String("inputs", "", "inputs directory, defaults to $"+internal.InputsDirEnv+" or inputs")
+	if len(args) == 0 || args[0] != "clear" {
+		_ = flags.Parse(args)
+		usageError(flags, errors.New("missing or unknown action, expected clear"))
+	}
+	_ = flags.Parse(args[1:])
+
+	path := internal.CachePath(settings().Inputs)
+	removed, err := internal.ClearCache(path)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(exitFailure)
+	}
+	if removed {
+		fmt.Printf("removed %s\n", path)
+	} else {
+		fmt.Printf("%s doesn't exist\n", path)
+	}
+}
+
+// configFlag registers the --config parameter. The returned function resolves the settings from the explicitly set
+// parameters, the environment variables, the configuration file and the defaults once the parameters are parsed.
+// Invalid settings are usage errors.
+func configFlag(flags *flag.FlagSet) func() config.Settings {
+	path := flags.String("config", "", "configuration file, defaults to $"+config.PathEnv+" or "+config.DefaultPath)
+	return func() config.Settings {
+		set := map[string]string{}
+		flags.Visit(func(f *flag.Flag) {
+			set[f.Name] = f.Value.String()
+		})
+
+		// only the default configuration file is optional
+		required := true
+		if *path == "" {
+			*path = os.Getenv(config.PathEnv)
+		}
+		if *path == "" {
+			*path, required = config.DefaultPath, false
+		}
+
+		file, err := config.Load(*path, required)
+		if err != nil {
+			usageError(flags, err)
+		}
+		s, err := config.Resolve(file, set, os.Getenv)
+		if err != nil {
+			usageError(flags, err)
+		}
+		return s
+	}
+}
+
+// verifyResults compares the answers with the recorded ones and saves the new answers if record is set
+func verifyResults(results []internal.Result, record bool, answersPath string) {
+	store, err := internal.LoadAnswers(answersPath)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(exitFailure)
+	}
+
+	internal.VerifyResults(store, results, record)
+	if !record {
+		return
+	}
+	if err := store.Save(); err != nil {
+		fmt.Println(err)
+		os.Exit(exitFailure)
+	}
+}
+
+// exitCode maps the error of a failed run to the exit code of the process
+func exitCode(err error) int {
+	var inputErr *internal.InputError
+	switch {
+	case errors.Is(err, registry.ErrSolverNotFound):
+		return exitNoSolver
+	case errors.Is(err, internal.ErrAnswerMismatch):
+		return exitMismatch
+	case errors.Is(err, internal.ErrTimeout), errors.Is(err, internal.ErrCancelled):
+		return exitTimeout
+	case errors.As(err, &inputErr) && errors.Is(err, fs.ErrNotExist):
+		return exitInputMissing
+	case errors.As(err, &inputErr):
+		return exitInputInvalid
+	default:
+		return exitFailure
+	}
 }
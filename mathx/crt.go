@@ -0,0 +1,60 @@
+package mathx
+
+import "fmt"
+
+// Congruence represents the numbers x for which x ≡ Residue (mod Modulus).
+type Congruence struct {
+	Residue int
+	Modulus int
+}
+
+// First gives back the smallest number of the congruence which isn't less than n.
+func (c Congruence) First(n int) int {
+	return n + Mod(c.Residue-n, c.Modulus)
+}
+
+// CRT solves the system of congruences with the Chinese remainder theorem, generalised to moduli which aren't
+// pairwise coprime. The solutions are given back as a single congruence modulo the least common multiple of the moduli.
+// It returns ErrNoSolution if the congruences contradict each other, and ErrOverflow if the combined modulus doesn't fit
+// into an int.
+func CRT(congruences ...Congruence) (Congruence, error) {
+	res := Congruence{Residue: 0, Modulus: 1}
+	for _, c := range congruences {
+		if c.Modulus <= 0 {
+			return Congruence{}, fmt.Errorf("%w: non-positive modulus %d", ErrNoSolution, c.Modulus)
+		}
+		var err error
+		if res, err = merge(res, Congruence{Residue: Mod(c.Residue, c.Modulus), Modulus: c.Modulus}); err != nil {
+			return Congruence{}, err
+		}
+	}
+	return res, nil
+}
+
+// merge combines two congruences with residues already reduced into a single one
+func merge(a Congruence, b Congruence) (Congruence, error) {
+	g := gcd(a.Modulus, b.Modulus)
+	diff := b.Residue - a.Residue
+	if diff%g != 0 {
+		return Congruence{}, fmt.Errorf("%w: x ≡ %d (mod %d) and x ≡ %d (mod %d)", ErrNoSolution, a.Residue, a.Modulus, b.Residue, b.Modulus)
+	}
+	l, ok := MulChecked(a.Modulus/g, b.Modulus)
+	if !ok {
+		return Congruence{}, fmt.Errorf("%w: combined modulus of %d and %d", ErrOverflow, a.Modulus, b.Modulus)
+	}
+
+	// a.Residue + a.Modulus*k ≡ b.Residue (mod b.Modulus), so k ≡ diff/g * inverse(a.Modulus/g) (mod b.Modulus/g)
+	m := b.Modulus / g
+	inv, err := ModInverse(a.Modulus/g, m)
+	if err != nil {
+		return Congruence{}, err
+	}
+	k := MulMod(diff/g, inv, m)
+
+	// a.Modulus*k < l, as k < m, and a.Residue < l, so the sum is reduced without overflowing
+	step := a.Modulus * k
+	if step >= l-a.Residue {
+		return Congruence{Residue: step - (l - a.Residue), Modulus: l}, nil
+	}
+	return Congruence{Residue: a.Residue + step, Modulus: l}, nil
+}
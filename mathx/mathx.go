@@ -0,0 +1,123 @@
+// Package mathx contains the number theory helpers shared by the challenges, such as the least common multiple of
+// cycle lengths and the Chinese remainder theorem for cycles with offsets.
+package mathx
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+)
+
+var (
+	// ErrOverflow is returned if a result doesn't fit into an int
+	ErrOverflow = errors.New("integer overflow")
+	// ErrNoSolution is returned if an equation or a system of congruences can't be solved
+	ErrNoSolution = errors.New("no solution")
+)
+
+// GCD calculates the greatest common divisor of the numbers, which is never negative.
+// The greatest common divisor of no numbers is 0.
+func GCD(numbers ...int) int {
+	g := 0
+	for _, n := range numbers {
+		g = gcd(g, n)
+	}
+	return g
+}
+
+// gcd calculates the greatest common divisor of a and b.
+func gcd(a int, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return abs(a)
+}
+
+// LCM calculates the least common multiple of the numbers, which is never negative.
+// The least common multiple of no numbers is 1. It returns ErrOverflow if the result doesn't fit into an int.
+func LCM(numbers ...int) (int, error) {
+	l := 1
+	for _, n := range numbers {
+		if n == 0 {
+			return 0, nil
+		}
+		m, ok := MulChecked(l/gcd(l, n), abs(n))
+		if !ok {
+			return 0, fmt.Errorf("%w: least common multiple of %v", ErrOverflow, numbers)
+		}
+		l = m
+	}
+	return l, nil
+}
+
+// ExtendedGCD calculates the greatest common divisor g of a and b along with the coefficients x and y, such that
+// a*x + b*y = g.
+func ExtendedGCD(a int, b int) (g int, x int, y int) {
+	oldR, r := a, b
+	oldX, x := 1, 0
+	oldY, y := 0, 1
+	for r != 0 {
+		q := oldR / r
+		oldR, r = r, oldR-q*r
+		oldX, x = x, oldX-q*x
+		oldY, y = y, oldY-q*y
+	}
+	if oldR < 0 {
+		return -oldR, -oldX, -oldY
+	}
+	return oldR, oldX, oldY
+}
+
+// Mod calculates the remainder of a divided by the positive modulus m, which is never negative.
+func Mod(a int, m int) int {
+	r := a % m
+	if r < 0 {
+		r += m
+	}
+	return r
+}
+
+// ModInverse calculates the number x in [0, m) for which a*x ≡ 1 (mod m).
+// It returns ErrNoSolution if a and m aren't coprime.
+func ModInverse(a int, m int) (int, error) {
+	if m <= 0 {
+		return 0, fmt.Errorf("%w: non-positive modulus %d", ErrNoSolution, m)
+	}
+	g, x, _ := ExtendedGCD(Mod(a, m), m)
+	if g != 1 {
+		return 0, fmt.Errorf("%w: %d has no inverse modulo %d", ErrNoSolution, a, m)
+	}
+	return Mod(x, m), nil
+}
+
+// MulChecked multiplies a and b, and reports whether the product fits into an int.
+func MulChecked(a int, b int) (int, bool) {
+	if a == 0 || b == 0 {
+		return 0, true
+	}
+	p := a * b
+	if p/b != a || (a == -1 && b == math.MinInt) || (b == -1 && a == math.MinInt) {
+		return 0, false
+	}
+	return p, true
+}
+
+// MulMod calculates a*b modulo the positive modulus m, which is never negative.
+// It falls back to math/big if the product doesn't fit into an int.
+func MulMod(a int, b int, m int) int {
+	a, b = Mod(a, m), Mod(b, m)
+	if p, ok := MulChecked(a, b); ok {
+		return p % m
+	}
+	p := new(big.Int).Mul(big.NewInt(int64(a)), big.NewInt(int64(b)))
+	return int(p.Mod(p, big.NewInt(int64(m))).Int64())
+}
+
+// abs calculates the absolute value of the number
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,108 @@
+package mathx_test
+
+import (
+	"errors"
+	"github.com/wlchs/advent_of_code_go_template/mathx"
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func TestGCDAndLCM(t *testing.T) {
+	t.Parallel()
+
+	if g := mathx.GCD(12, -18, 30); g != 6 {
+		t.Errorf("expected a greatest common divisor of 6, but got %d instead", g)
+	}
+	if l, err := mathx.LCM(4, 6, 10); err != nil || l != 60 {
+		t.Errorf("expected a least common multiple of 60, but got %d, %v instead", l, err)
+	}
+	if _, err := mathx.LCM(math.MaxInt, math.MaxInt-1); !errors.Is(err, mathx.ErrOverflow) {
+		t.Errorf("expected ErrOverflow, but got %v instead", err)
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for run := 0; run < 1000; run++ {
+		a, b := r.Intn(2000)-1000, r.Intn(2000)-1000
+		g, x, y := mathx.ExtendedGCD(a, b)
+		if g != mathx.GCD(a, b) || a*x+b*y != g {
+			t.Fatalf("invalid extended gcd of %d and %d: %d = %d*%d + %d*%d", a, b, g, a, x, b, y)
+		}
+	}
+}
+
+func TestModInverse(t *testing.T) {
+	t.Parallel()
+
+	if x, err := mathx.ModInverse(-3, 7); err != nil || x != 2 {
+		t.Errorf("expected the inverse 2, but got %d, %v instead", x, err)
+	}
+	if _, err := mathx.ModInverse(4, 6); !errors.Is(err, mathx.ErrNoSolution) {
+		t.Errorf("expected ErrNoSolution, but got %v instead", err)
+	}
+}
+
+func TestMulMod(t *testing.T) {
+	t.Parallel()
+
+	if _, ok := mathx.MulChecked(math.MinInt, -1); ok {
+		t.Error("expected an overflow")
+	}
+	if p, ok := mathx.MulChecked(-1<<31, 1<<31); !ok || p != -1<<62 {
+		t.Errorf("expected no overflow, but got %d, %t instead", p, ok)
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for run := 0; run < 1000; run++ {
+		a, b, m := r.Int()-r.Int(), r.Int(), r.Intn(math.MaxInt)+1
+		expected := new(big.Int).Mul(big.NewInt(int64(a)), big.NewInt(int64(b)))
+		expected.Mod(expected, big.NewInt(int64(m)))
+		if p := mathx.MulMod(a, b, m); int64(p) != expected.Int64() {
+			t.Fatalf("expected %d*%d mod %d to be %s, but got %d instead", a, b, m, expected, p)
+		}
+	}
+}
+
+func TestCRT(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewSource(1))
+	for run := 0; run < 1000; run++ {
+		var congruences []mathx.Congruence
+		for n := r.Intn(4); n > 0; n-- {
+			congruences = append(congruences, mathx.Congruence{Residue: r.Intn(40) - 20, Modulus: r.Intn(12) + 1})
+		}
+
+		// the solutions repeat with the least common multiple of the moduli, which is at most 12^3
+		var solutions []int
+		for x := 0; x < 2*12*12*12; x++ {
+			ok := true
+			for _, c := range congruences {
+				ok = ok && mathx.Mod(x-c.Residue, c.Modulus) == 0
+			}
+			if ok {
+				solutions = append(solutions, x)
+			}
+		}
+
+		c, err := mathx.CRT(congruences...)
+		if len(solutions) == 0 {
+			if !errors.Is(err, mathx.ErrNoSolution) {
+				t.Fatalf("expected no solution for %v, but got %v, %v instead", congruences, c, err)
+			}
+			continue
+		}
+		if err != nil || c.Residue != solutions[0] || (len(solutions) > 1 && c.Modulus != solutions[1]-solutions[0]) {
+			t.Fatalf("expected the solutions %v of %v, but got %v, %v instead", solutions[:min(len(solutions), 2)], congruences, c, err)
+		}
+		if first := c.First(solutions[0] + 1); len(solutions) > 1 && first != solutions[1] {
+			t.Fatalf("expected the next solution %d, but got %d instead", solutions[1], first)
+		}
+	}
+
+	large := mathx.Congruence{Residue: 1, Modulus: math.MaxInt / 2}
+	if _, err := mathx.CRT(large, mathx.Congruence{Residue: 0, Modulus: 5}); !errors.Is(err, mathx.ErrOverflow) {
+		t.Errorf("expected ErrOverflow, but got %v instead", err)
+	}
+}
@@ -0,0 +1,216 @@
+package types
+
+import "fmt"
+
+// Grid is a rectangular 2D map of values backed by a flat slice in row-major order.
+// The top left corner is at (0, 0), X grows to the right and Y grows downwards.
+type Grid[T any] struct {
+	width  int
+	height int
+	cells  []T
+}
+
+// NewGrid creates a grid of the given size filled with the zero value of T.
+func NewGrid[T any](width int, height int) *Grid[T] {
+	if width < 0 || height < 0 {
+		panic(fmt.Sprintf("invalid grid size %dx%d", width, height))
+	}
+	return &Grid[T]{width: width, height: height, cells: make([]T, width*height)}
+}
+
+// ParseGrid reads the input rows into a grid of characters.
+// The width of the grid is the length of the longest row, shorter rows are padded with the zero value.
+func ParseGrid(input []string) *Grid[rune] {
+	return ParseGridFunc(input, func(r rune) rune {
+		return r
+	})
+}
+
+// ParseGridFunc reads the input rows into a grid, converting each character with the given function.
+// The width of the grid is the length of the longest row, shorter rows are padded with the zero value.
+func ParseGridFunc[T any](input []string, convert func(rune) T) *Grid[T] {
+	rows := make([][]rune, 0, len(input))
+	width := 0
+	for _, row := range input {
+		rows = append(rows, []rune(row))
+		width = max(width, len(rows[len(rows)-1]))
+	}
+
+	g := NewGrid[T](width, len(rows))
+	for y, row := range rows {
+		for x, r := range row {
+			g.cells[y*width+x] = convert(r)
+		}
+	}
+	return g
+}
+
+// Width gives back the number of columns of the grid.
+func (g *Grid[T]) Width() int {
+	return g.width
+}
+
+// Height gives back the number of rows of the grid.
+func (g *Grid[T]) Height() int {
+	return g.height
+}
+
+// In checks whether the position is inside the grid.
+func (g *Grid[T]) In(v Vec2) bool {
+	return v.X >= 0 && v.Y >= 0 && v.X < g.width && v.Y < g.height
+}
+
+// At gives back the value at the given position, or the zero value if the position is outside the grid.
+func (g *Grid[T]) At(v Vec2) T {
+	if !g.In(v) {
+		var zero T
+		return zero
+	}
+	return g.cells[v.Y*g.width+v.X]
+}
+
+// Set changes the value at the given position. It panics if the position is outside the grid.
+func (g *Grid[T]) Set(v Vec2, value T) {
+	if !g.In(v) {
+		panic(fmt.Sprintf("position %v outside the %dx%d grid", v, g.width, g.height))
+	}
+	g.cells[v.Y*g.width+v.X] = value
+}
+
+// Row gives back a copy of the values of the row with the given index from left to right.
+func (g *Grid[T]) Row(y int) []T {
+	if y < 0 || y >= g.height {
+		panic(fmt.Sprintf("row %d outside the %dx%d grid", y, g.width, g.height))
+	}
+	return append([]T(nil), g.cells[y*g.width:(y+1)*g.width]...)
+}
+
+// Column gives back a copy of the values of the column with the given index from top to bottom.
+func (g *Grid[T]) Column(x int) []T {
+	if x < 0 || x >= g.width {
+		panic(fmt.Sprintf("column %d outside the %dx%d grid", x, g.width, g.height))
+	}
+	column := make([]T, 0, g.height)
+	for y := 0; y < g.height; y++ {
+		column = append(column, g.cells[y*g.width+x])
+	}
+	return column
+}
+
+// Rows gives back a copy of the values row by row.
+func (g *Grid[T]) Rows() [][]T {
+	rows := make([][]T, 0, g.height)
+	for y := 0; y < g.height; y++ {
+		rows = append(rows, g.Row(y))
+	}
+	return rows
+}
+
+// Columns gives back a copy of the values column by column.
+func (g *Grid[T]) Columns() [][]T {
+	columns := make([][]T, 0, g.width)
+	for x := 0; x < g.width; x++ {
+		columns = append(columns, g.Column(x))
+	}
+	return columns
+}
+
+// Each calls the function with every position and its value in row-major order.
+// The function may change the values of the grid, the later calls see the changes.
+func (g *Grid[T]) Each(f func(v Vec2, value T)) {
+	for i := range g.cells {
+		f(Vec2{X: i % g.width, Y: i / g.width}, g.cells[i])
+	}
+}
+
+// Neighbors4 gives back the positions above, left, below and right to the given one which are inside the grid.
+func (g *Grid[T]) Neighbors4(v Vec2) []Vec2 {
+	return g.inside(v.Around())
+}
+
+// Neighbors8 gives back the positions around the given one, including the diagonal ones, which are inside the grid.
+// The order of the positions is clockwise starting from the top left one.
+func (g *Grid[T]) Neighbors8(v Vec2) []Vec2 {
+	return g.inside(v.Neighbors8())
+}
+
+// inside filters the positions which are inside the grid
+func (g *Grid[T]) inside(positions []Vec2) []Vec2 {
+	res := positions[:0]
+	for _, p := range positions {
+		if g.In(p) {
+			res = append(res, p)
+		}
+	}
+	return res
+}
+
+// Find gives back the first position in row-major order whose value matches the predicate.
+func (g *Grid[T]) Find(match func(T) bool) (Vec2, bool) {
+	for i, value := range g.cells {
+		if match(value) {
+			return Vec2{X: i % g.width, Y: i / g.width}, true
+		}
+	}
+	return Vec2{}, false
+}
+
+// FindAll gives back every position in row-major order whose value matches the predicate.
+func (g *Grid[T]) FindAll(match func(T) bool) []Vec2 {
+	var res []Vec2
+	for i, value := range g.cells {
+		if match(value) {
+			res = append(res, Vec2{X: i % g.width, Y: i / g.width})
+		}
+	}
+	return res
+}
+
+// Clone gives back an independent copy of the grid.
+func (g *Grid[T]) Clone() *Grid[T] {
+	return &Grid[T]{width: g.width, height: g.height, cells: append([]T(nil), g.cells...)}
+}
+
+// Transpose gives back a new grid mirrored along its main diagonal, so that the rows become the columns.
+func (g *Grid[T]) Transpose() *Grid[T] {
+	return g.transform(g.height, g.width, func(v Vec2) Vec2 {
+		return Vec2{X: v.Y, Y: v.X}
+	})
+}
+
+// RotateRight gives back a new grid rotated 90 degrees clockwise.
+func (g *Grid[T]) RotateRight() *Grid[T] {
+	return g.transform(g.height, g.width, func(v Vec2) Vec2 {
+		return Vec2{X: g.height - 1 - v.Y, Y: v.X}
+	})
+}
+
+// RotateLeft gives back a new grid rotated 90 degrees counterclockwise.
+func (g *Grid[T]) RotateLeft() *Grid[T] {
+	return g.transform(g.height, g.width, func(v Vec2) Vec2 {
+		return Vec2{X: v.Y, Y: g.width - 1 - v.X}
+	})
+}
+
+// FlipHorizontal gives back a new grid mirrored along its vertical axis, so that the left and right sides swap.
+func (g *Grid[T]) FlipHorizontal() *Grid[T] {
+	return g.transform(g.width, g.height, func(v Vec2) Vec2 {
+		return Vec2{X: g.width - 1 - v.X, Y: v.Y}
+	})
+}
+
+// FlipVertical gives back a new grid mirrored along its horizontal axis, so that the top and bottom sides swap.
+func (g *Grid[T]) FlipVertical() *Grid[T] {
+	return g.transform(g.width, g.height, func(v Vec2) Vec2 {
+		return Vec2{X: v.X, Y: g.height - 1 - v.Y}
+	})
+}
+
+// transform creates a grid of the given size and moves every value of the grid to the position given by the function
+func (g *Grid[T]) transform(width int, height int, position func(Vec2) Vec2) *Grid[T] {
+	res := NewGrid[T](width, height)
+	g.Each(func(v Vec2, value T) {
+		res.Set(position(v), value)
+	})
+	return res
+}
@@ -0,0 +1,103 @@
+package types_test
+
+import (
+	"github.com/wlchs/advent_of_code_go_template/types"
+	"reflect"
+	"testing"
+)
+
+// text converts the rows of a character grid back to strings
+func text(g *types.Grid[rune]) []string {
+	var res []string
+	for _, row := range g.Rows() {
+		res = append(res, string(row))
+	}
+	return res
+}
+
+func TestParseGrid(t *testing.T) {
+	t.Parallel()
+
+	g := types.ParseGrid([]string{"ab", "cde"})
+	if g.Width() != 3 || g.Height() != 2 {
+		t.Fatalf("expected a 3x2 grid, but got %dx%d instead", g.Width(), g.Height())
+	}
+	if g.At(types.Vec2{X: 2, Y: 1}) != 'e' || g.At(types.Vec2{X: 2, Y: 0}) != 0 {
+		t.Error("expected the short rows to be padded with the zero value")
+	}
+	if g.At(types.Vec2{X: -1, Y: 0}) != 0 || g.In(types.Vec2{X: 3, Y: 0}) {
+		t.Error("expected the positions outside the grid to be reported")
+	}
+	if !reflect.DeepEqual(g.Column(1), []rune("bd")) {
+		t.Errorf("unexpected column %q", string(g.Column(1)))
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected setting a value outside the grid to panic")
+		}
+	}()
+	g.Set(types.Vec2{X: 0, Y: 2}, 'x')
+}
+
+func TestGridNeighbors(t *testing.T) {
+	t.Parallel()
+
+	g := types.NewGrid[int](3, 3)
+	corner := []types.Vec2{{X: 0, Y: 1}, {X: 1, Y: 0}}
+	if n := g.Neighbors4(types.Vec2{}); !reflect.DeepEqual(n, corner) {
+		t.Errorf("expected %v, but got %v instead", corner, n)
+	}
+	corner = []types.Vec2{{X: 1, Y: 0}, {X: 1, Y: 1}, {X: 0, Y: 1}}
+	if n := g.Neighbors8(types.Vec2{}); !reflect.DeepEqual(n, corner) {
+		t.Errorf("expected %v, but got %v instead", corner, n)
+	}
+	if n := g.Neighbors8(types.Vec2{X: 1, Y: 1}); len(n) != 8 {
+		t.Errorf("expected 8 neighbours of the center, but got %v instead", n)
+	}
+}
+
+func TestGridFind(t *testing.T) {
+	t.Parallel()
+
+	g := types.ParseGrid([]string{".#.", "#.."})
+	isRock := func(r rune) bool { return r == '#' }
+	if v, ok := g.Find(isRock); !ok || v != (types.Vec2{X: 1, Y: 0}) {
+		t.Errorf("unexpected first match %v, %t", v, ok)
+	}
+	if all := g.FindAll(isRock); !reflect.DeepEqual(all, []types.Vec2{{X: 1, Y: 0}, {X: 0, Y: 1}}) {
+		t.Errorf("unexpected matches %v", all)
+	}
+	if _, ok := g.Find(func(r rune) bool { return r == 'S' }); ok {
+		t.Error("expected no match")
+	}
+
+	c := g.Clone()
+	c.Set(types.Vec2{}, '#')
+	if g.At(types.Vec2{}) != '.' {
+		t.Error("expected the clone to be independent of the original grid")
+	}
+}
+
+func TestGridTransform(t *testing.T) {
+	t.Parallel()
+
+	g := types.ParseGrid([]string{"abc", "def"})
+	tests := []struct {
+		name     string
+		grid     *types.Grid[rune]
+		expected []string
+	}{
+		{"transpose", g.Transpose(), []string{"ad", "be", "cf"}},
+		{"rotate right", g.RotateRight(), []string{"da", "eb", "fc"}},
+		{"rotate left", g.RotateLeft(), []string{"cf", "be", "ad"}},
+		{"flip horizontal", g.FlipHorizontal(), []string{"cba", "fed"}},
+		{"flip vertical", g.FlipVertical(), []string{"def", "abc"}},
+		{"full turn", g.RotateRight().RotateRight().RotateRight().RotateRight(), []string{"abc", "def"}},
+	}
+	for _, tt := range tests {
+		if actual := text(tt.grid); !reflect.DeepEqual(actual, tt.expected) {
+			t.Errorf("%s: expected %q, but got %q instead", tt.name, tt.expected, actual)
+		}
+	}
+}
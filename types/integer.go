@@ -0,0 +1,14 @@
+package types
+
+// Integer is the set of the integer types the vectors can be built from
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
+// abs calculates the absolute value of the number
+func abs[T Integer](n T) T {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
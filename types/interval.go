@@ -0,0 +1,194 @@
+package types
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"slices"
+)
+
+// Interval is a half-open range of integers, containing Start but not End.
+type Interval struct {
+	Start int
+	End   int
+}
+
+// HalfOpen creates the interval containing the numbers from start up to, but not including, end.
+func HalfOpen(start int, end int) Interval {
+	return Interval{Start: start, End: end}
+}
+
+// Closed creates the interval containing the numbers from first to last, including both.
+func Closed(first int, last int) Interval {
+	return Interval{Start: first, End: last + 1}
+}
+
+// Last gives back the largest number of the interval, it is only meaningful if the interval isn't empty.
+func (i Interval) Last() int {
+	return i.End - 1
+}
+
+// Empty checks whether the interval contains no numbers.
+func (i Interval) Empty() bool {
+	return i.End <= i.Start
+}
+
+// Len gives back the number of numbers in the interval.
+func (i Interval) Len() int {
+	return max(i.End-i.Start, 0)
+}
+
+// Contains checks whether the number is in the interval.
+func (i Interval) Contains(n int) bool {
+	return n >= i.Start && n < i.End
+}
+
+// Intersect gives back the common part of two intervals, which may be empty.
+func (i Interval) Intersect(o Interval) Interval {
+	return Interval{Start: max(i.Start, o.Start), End: min(i.End, o.End)}
+}
+
+// Shift translates the interval by the given offset.
+func (i Interval) Shift(offset int) Interval {
+	return Interval{Start: i.Start + offset, End: i.End + offset}
+}
+
+// String formats the interval as [Start, End).
+func (i Interval) String() string {
+	return fmt.Sprintf("[%d, %d)", i.Start, i.End)
+}
+
+// IntervalSet is an immutable set of integers stored as a list of disjoint intervals.
+// The intervals are kept sorted, and the touching or overlapping ones are merged.
+type IntervalSet struct {
+	intervals []Interval
+}
+
+// NewIntervalSet creates the set of numbers contained by any of the intervals.
+func NewIntervalSet(intervals ...Interval) IntervalSet {
+	return normalize(slices.Clone(intervals))
+}
+
+// normalize sorts the intervals, drops the empty ones and merges the ones touching each other
+func normalize(intervals []Interval) IntervalSet {
+	intervals = slices.DeleteFunc(intervals, Interval.Empty)
+	slices.SortFunc(intervals, func(a, b Interval) int {
+		return cmp.Compare(a.Start, b.Start)
+	})
+	var res []Interval
+	for _, i := range intervals {
+		if len(res) > 0 && i.Start <= res[len(res)-1].End {
+			res[len(res)-1].End = max(res[len(res)-1].End, i.End)
+		} else {
+			res = append(res, i)
+		}
+	}
+	return IntervalSet{intervals: res}
+}
+
+// Intervals gives back a copy of the disjoint intervals of the set in increasing order.
+func (s IntervalSet) Intervals() []Interval {
+	return slices.Clone(s.intervals)
+}
+
+// Empty checks whether the set contains no numbers.
+func (s IntervalSet) Empty() bool {
+	return len(s.intervals) == 0
+}
+
+// Len gives back the number of numbers in the set.
+func (s IntervalSet) Len() int {
+	n := 0
+	for _, i := range s.intervals {
+		n += i.Len()
+	}
+	return n
+}
+
+// Min gives back the smallest number of the set, or false if the set is empty.
+func (s IntervalSet) Min() (int, bool) {
+	if s.Empty() {
+		return 0, false
+	}
+	return s.intervals[0].Start, true
+}
+
+// Contains checks whether the number is in the set.
+func (s IntervalSet) Contains(n int) bool {
+	i, found := slices.BinarySearchFunc(s.intervals, n, func(i Interval, n int) int {
+		return cmp.Compare(i.Start, n)
+	})
+	if found {
+		return true
+	}
+	return i > 0 && s.intervals[i-1].Contains(n)
+}
+
+// Each calls the function with every number of the set in increasing order until it returns false.
+func (s IntervalSet) Each(f func(n int) bool) {
+	for _, i := range s.intervals {
+		for n := i.Start; n < i.End; n++ {
+			if !f(n) {
+				return
+			}
+		}
+	}
+}
+
+// Union gives back the numbers contained by either of the sets.
+func (s IntervalSet) Union(o IntervalSet) IntervalSet {
+	return normalize(append(slices.Clone(s.intervals), o.intervals...))
+}
+
+// Intersection gives back the numbers contained by both sets.
+func (s IntervalSet) Intersection(o IntervalSet) IntervalSet {
+	var res []Interval
+	for i, j := 0, 0; i < len(s.intervals) && j < len(o.intervals); {
+		if common := s.intervals[i].Intersect(o.intervals[j]); !common.Empty() {
+			res = append(res, common)
+		}
+		if s.intervals[i].End < o.intervals[j].End {
+			i++
+		} else {
+			j++
+		}
+	}
+	return IntervalSet{intervals: res}
+}
+
+// Difference gives back the numbers of the set which aren't contained by the other one.
+func (s IntervalSet) Difference(o IntervalSet) IntervalSet {
+	var res []Interval
+	j := 0
+	for _, i := range s.intervals {
+		for j < len(o.intervals) && o.intervals[j].End <= i.Start {
+			j++
+		}
+		for k := j; k < len(o.intervals) && o.intervals[k].Start < i.End; k++ {
+			if o.intervals[k].Start > i.Start {
+				res = append(res, Interval{Start: i.Start, End: o.intervals[k].Start})
+			}
+			i.Start = o.intervals[k].End
+		}
+		if !i.Empty() {
+			res = append(res, i)
+		}
+	}
+	return IntervalSet{intervals: res}
+}
+
+// SplitAt divides the set into the numbers below n and the numbers greater than or equal to n.
+func (s IntervalSet) SplitAt(n int) (IntervalSet, IntervalSet) {
+	below := s.Intersection(NewIntervalSet(Interval{Start: math.MinInt, End: n}))
+	above := s.Intersection(NewIntervalSet(Interval{Start: n, End: math.MaxInt}))
+	return below, above
+}
+
+// Shift translates every number of the set by the given offset.
+func (s IntervalSet) Shift(offset int) IntervalSet {
+	res := make([]Interval, 0, len(s.intervals))
+	for _, i := range s.intervals {
+		res = append(res, i.Shift(offset))
+	}
+	return IntervalSet{intervals: res}
+}
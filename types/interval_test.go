@@ -0,0 +1,156 @@
+package types_test
+
+import (
+	"github.com/wlchs/advent_of_code_go_template/types"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// bound limits the numbers of the random sets, so that they can be checked number by number
+const bound = 30
+
+// bruteForce is a plain set of numbers the interval sets are checked against
+type bruteForce map[int]bool
+
+// randomSet generates a random interval set from a few intervals, along with the equivalent plain set
+func randomSet(r *rand.Rand) (types.IntervalSet, bruteForce) {
+	var intervals []types.Interval
+	set := bruteForce{}
+	for n := r.Intn(5); n > 0; n-- {
+		i := types.HalfOpen(r.Intn(bound)-bound/2, r.Intn(bound)-bound/2)
+		if r.Intn(2) == 0 {
+			i = types.Closed(i.Start, i.End)
+		}
+		intervals = append(intervals, i)
+		for x := i.Start; x < i.End; x++ {
+			set[x] = true
+		}
+	}
+	return types.NewIntervalSet(intervals...), set
+}
+
+// checkSet checks that the interval set contains the same numbers as the plain set and that it is normalized
+func checkSet(t *testing.T, name string, s types.IntervalSet, expected bruteForce) {
+	t.Helper()
+	var actual []int
+	s.Each(func(n int) bool {
+		actual = append(actual, n)
+		return true
+	})
+	for i, n := range actual {
+		if !expected[n] || (i > 0 && actual[i-1] >= n) {
+			t.Fatalf("%s: unexpected numbers %v, expected %v", name, actual, expected)
+		}
+	}
+	if len(actual) != len(expected) || s.Len() != len(expected) {
+		t.Fatalf("%s: expected %d numbers, but got %v with length %d", name, len(expected), actual, s.Len())
+	}
+	for x := -bound * 2; x < bound*2; x++ {
+		if s.Contains(x) != expected[x] {
+			t.Fatalf("%s: unexpected membership of %d in %v", name, x, s.Intervals())
+		}
+	}
+	intervals := s.Intervals()
+	for i := 1; i < len(intervals); i++ {
+		if intervals[i].Start <= intervals[i-1].End {
+			t.Fatalf("%s: the intervals %v aren't merged", name, intervals)
+		}
+	}
+}
+
+func TestIntervalSetProperties(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewSource(1))
+	for run := 0; run < 1000; run++ {
+		a, setA := randomSet(r)
+		b, setB := randomSet(r)
+		checkSet(t, "set", a, setA)
+
+		union, intersection, difference := bruteForce{}, bruteForce{}, bruteForce{}
+		for x := range setA {
+			union[x] = true
+			if setB[x] {
+				intersection[x] = true
+			} else {
+				difference[x] = true
+			}
+		}
+		for x := range setB {
+			union[x] = true
+		}
+		checkSet(t, "union", a.Union(b), union)
+		checkSet(t, "intersection", a.Intersection(b), intersection)
+		checkSet(t, "difference", a.Difference(b), difference)
+
+		n := r.Intn(bound) - bound/2
+		below, above := bruteForce{}, bruteForce{}
+		for x := range setA {
+			if x < n {
+				below[x] = true
+			} else {
+				above[x] = true
+			}
+		}
+		lo, hi := a.SplitAt(n)
+		checkSet(t, "below", lo, below)
+		checkSet(t, "above", hi, above)
+	}
+}
+
+// randomMapping generates a mapping from a few random, possibly overlapping ranges
+func randomMapping(r *rand.Rand) types.Mapping {
+	var ranges []types.OffsetRange
+	for n := r.Intn(4); n > 0; n-- {
+		start := r.Intn(bound) - bound/2
+		ranges = append(ranges, types.OffsetRange{
+			Interval: types.HalfOpen(start, start+r.Intn(bound/2)),
+			Offset:   r.Intn(bound) - bound/2,
+		})
+	}
+	return types.NewMapping(ranges...)
+}
+
+func TestMappingProperties(t *testing.T) {
+	t.Parallel()
+
+	r := rand.New(rand.NewSource(1))
+	for run := 0; run < 1000; run++ {
+		m := randomMapping(r)
+		next := randomMapping(r)
+		composed := m.Then(next)
+		for x := -bound * 2; x < bound*2; x++ {
+			if expected := next.Map(m.Map(x)); composed.Map(x) != expected {
+				t.Fatalf("expected %d to be mapped to %d, but got %d by %v", x, expected, composed.Map(x), composed.Ranges())
+			}
+		}
+
+		s, set := randomSet(r)
+		image := bruteForce{}
+		for x := range set {
+			image[m.Map(x)] = true
+		}
+		checkSet(t, "image", m.Apply(s), image)
+	}
+}
+
+func TestMappingFirstRangeWins(t *testing.T) {
+	t.Parallel()
+
+	m := types.NewMapping(
+		types.OffsetRange{Interval: types.Closed(0, 9), Offset: 100},
+		types.OffsetRange{Interval: types.Closed(5, 14), Offset: -100},
+		types.OffsetRange{Interval: types.Closed(20, 29)},
+	)
+	expected := []types.OffsetRange{
+		{Interval: types.HalfOpen(0, 10), Offset: 100},
+		{Interval: types.HalfOpen(10, 15), Offset: -100},
+	}
+	if !reflect.DeepEqual(m.Ranges(), expected) {
+		t.Errorf("expected %v, but got %v instead", expected, m.Ranges())
+	}
+	if m.Map(7) != 107 || m.Map(12) != -88 || m.Map(25) != 25 {
+		t.Errorf("unexpected mapping of 7, 12 and 25: %d, %d, %d", m.Map(7), m.Map(12), m.Map(25))
+	}
+}
@@ -0,0 +1,104 @@
+package types
+
+import (
+	"cmp"
+	"slices"
+)
+
+// OffsetRange maps the numbers of its interval by adding the offset to them.
+type OffsetRange struct {
+	Interval
+	Offset int
+}
+
+// Mapping is a piecewise function over the integers, which shifts the numbers of each of its ranges by the offset of
+// the range, and keeps every other number unchanged.
+type Mapping struct {
+	ranges []OffsetRange
+}
+
+// NewMapping creates a mapping from the given ranges.
+// If the ranges overlap, a number is mapped by the first range containing it.
+func NewMapping(ranges ...OffsetRange) Mapping {
+	var res []OffsetRange
+	covered := IntervalSet{}
+	for _, r := range ranges {
+		free := NewIntervalSet(r.Interval).Difference(covered)
+		for _, i := range free.intervals {
+			res = append(res, OffsetRange{Interval: i, Offset: r.Offset})
+		}
+		covered = covered.Union(free)
+	}
+	return newMapping(res)
+}
+
+// newMapping sorts the disjoint ranges and drops the ones which don't change the numbers
+func newMapping(ranges []OffsetRange) Mapping {
+	ranges = slices.DeleteFunc(ranges, func(r OffsetRange) bool {
+		return r.Offset == 0 || r.Empty()
+	})
+	slices.SortFunc(ranges, func(a, b OffsetRange) int {
+		return cmp.Compare(a.Start, b.Start)
+	})
+	return Mapping{ranges: ranges}
+}
+
+// Ranges gives back a copy of the disjoint ranges of the mapping in increasing order.
+func (m Mapping) Ranges() []OffsetRange {
+	return slices.Clone(m.ranges)
+}
+
+// Map gives back the image of a single number.
+func (m Mapping) Map(n int) int {
+	i, found := slices.BinarySearchFunc(m.ranges, n, func(r OffsetRange, n int) int {
+		return cmp.Compare(r.Start, n)
+	})
+	if found {
+		return n + m.ranges[i].Offset
+	}
+	if i > 0 && m.ranges[i-1].Contains(n) {
+		return n + m.ranges[i-1].Offset
+	}
+	return n
+}
+
+// Apply gives back the image of every number of the set.
+func (m Mapping) Apply(s IntervalSet) IntervalSet {
+	var res []Interval
+	rest := s
+	for _, r := range m.ranges {
+		mapped := s.Intersection(NewIntervalSet(r.Interval))
+		for _, i := range mapped.intervals {
+			res = append(res, i.Shift(r.Offset))
+		}
+		rest = rest.Difference(mapped)
+	}
+	return normalize(append(res, rest.intervals...))
+}
+
+// Then gives back the mapping which first applies the current mapping and then the next one.
+func (m Mapping) Then(next Mapping) Mapping {
+	var res []OffsetRange
+	domain := IntervalSet{}
+	for _, r := range m.ranges {
+		domain = domain.Union(NewIntervalSet(r.Interval))
+		// the image of the range is shifted further by the ranges of the next mapping it reaches
+		image := NewIntervalSet(r.Interval.Shift(r.Offset))
+		for _, n := range next.ranges {
+			for _, i := range image.Intersection(NewIntervalSet(n.Interval)).intervals {
+				res = append(res, OffsetRange{Interval: i.Shift(-r.Offset), Offset: r.Offset + n.Offset})
+			}
+			image = image.Difference(NewIntervalSet(n.Interval))
+		}
+		for _, i := range image.intervals {
+			res = append(res, OffsetRange{Interval: i.Shift(-r.Offset), Offset: r.Offset})
+		}
+	}
+	// the numbers kept unchanged by the current mapping are only mapped by the next one
+	for _, n := range next.ranges {
+		for _, i := range NewIntervalSet(n.Interval).Difference(domain).intervals {
+			res = append(res, OffsetRange{Interval: i, Offset: n.Offset})
+		}
+	}
+	return newMapping(res)
+}
@@ -0,0 +1,69 @@
+package types
+
+import "container/heap"
+
+// PriorityQueue is a min-priority queue of values.
+// Values with the same priority are popped in the order they were pushed.
+type PriorityQueue[T any] struct {
+	items queueItems[T]
+	seq   int
+}
+
+// queueItem is a value of the priority queue with its priority and insertion sequence number
+type queueItem[T any] struct {
+	value    T
+	priority int
+	seq      int
+}
+
+// queueItems implements heap.Interface over the items of the priority queue
+type queueItems[T any] []queueItem[T]
+
+func (q queueItems[T]) Len() int {
+	return len(q)
+}
+
+func (q queueItems[T]) Less(i, j int) bool {
+	if q[i].priority != q[j].priority {
+		return q[i].priority < q[j].priority
+	}
+	return q[i].seq < q[j].seq
+}
+
+func (q queueItems[T]) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+}
+
+func (q *queueItems[T]) Push(x any) {
+	*q = append(*q, x.(queueItem[T]))
+}
+
+func (q *queueItems[T]) Pop() any {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return item
+}
+
+// NewPriorityQueue creates an empty priority queue.
+func NewPriorityQueue[T any]() *PriorityQueue[T] {
+	return &PriorityQueue[T]{}
+}
+
+// Len gives back the number of values in the queue.
+func (q *PriorityQueue[T]) Len() int {
+	return q.items.Len()
+}
+
+// Push adds the value to the queue with the given priority.
+func (q *PriorityQueue[T]) Push(value T, priority int) {
+	heap.Push(&q.items, queueItem[T]{value: value, priority: priority, seq: q.seq})
+	q.seq++
+}
+
+// Pop removes the value with the lowest priority from the queue and gives it back with its priority.
+// It panics if the queue is empty.
+func (q *PriorityQueue[T]) Pop() (T, int) {
+	item := heap.Pop(&q.items).(queueItem[T])
+	return item.value, item.priority
+}
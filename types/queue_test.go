@@ -0,0 +1,26 @@
+package types_test
+
+import (
+	"github.com/wlchs/advent_of_code_go_template/types"
+	"testing"
+)
+
+func TestPriorityQueue(t *testing.T) {
+	t.Parallel()
+
+	q := types.NewPriorityQueue[string]()
+	q.Push("c", 3)
+	q.Push("a", 1)
+	q.Push("b", 2)
+	q.Push("a2", 1)
+
+	expected := []string{"a", "a2", "b", "c"}
+	for _, e := range expected {
+		if v, _ := q.Pop(); v != e {
+			t.Errorf("expected %q, but got %q instead", e, v)
+		}
+	}
+	if q.Len() != 0 {
+		t.Errorf("expected an empty queue, but got %d items", q.Len())
+	}
+}
@@ -1,37 +1,73 @@
 package types
 
-import "math"
+import (
+	"errors"
+	"fmt"
+)
 
-// Vec2 defines a pair of X Y values of a 2D vector
-type Vec2 struct {
-	X int
-	Y int
+// ErrInvalidDirection is returned if a character doesn't represent a direction
+var ErrInvalidDirection = errors.New("invalid direction")
+
+// Vector2 defines a pair of X Y values of a 2D vector with coordinates of any integer type
+type Vector2[T Integer] struct {
+	X T
+	Y T
+}
+
+// Vec2 is the 2D vector of int coordinates used by most of the challenges
+type Vec2 = Vector2[int]
+
+// The unit vectors of the directions on the map, where Y grows downwards
+var (
+	N = Vec2{Y: -1}
+	E = Vec2{X: 1}
+	S = Vec2{Y: 1}
+	W = Vec2{X: -1}
+)
+
+// Directions contains the unit vectors of the four directions clockwise starting from north
+var Directions = []Vec2{N, E, S, W}
+
+// ParseDirection converts a direction character to its unit vector.
+// Both the U, D, L, R letters and the ^, v, <, > arrows are accepted.
+func ParseDirection(r rune) (Vec2, error) {
+	switch r {
+	case 'U', '^':
+		return N, nil
+	case 'R', '>':
+		return E, nil
+	case 'D', 'v':
+		return S, nil
+	case 'L', '<':
+		return W, nil
+	}
+	return Vec2{}, fmt.Errorf("%w: %q", ErrInvalidDirection, r)
 }
 
 // Up calculates the location vector above the current one
-func (c Vec2) Up() Vec2 {
-	return Vec2{c.X, c.Y - 1}
+func (c Vector2[T]) Up() Vector2[T] {
+	return Vector2[T]{c.X, c.Y - 1}
 }
 
 // Down calculates the location vector below the current one
-func (c Vec2) Down() Vec2 {
-	return Vec2{c.X, c.Y + 1}
+func (c Vector2[T]) Down() Vector2[T] {
+	return Vector2[T]{c.X, c.Y + 1}
 }
 
 // Left calculates the location vector left to the current one
-func (c Vec2) Left() Vec2 {
-	return Vec2{c.X - 1, c.Y}
+func (c Vector2[T]) Left() Vector2[T] {
+	return Vector2[T]{c.X - 1, c.Y}
 }
 
 // Right calculates the location vector right to the current one
-func (c Vec2) Right() Vec2 {
-	return Vec2{c.X + 1, c.Y}
+func (c Vector2[T]) Right() Vector2[T] {
+	return Vector2[T]{c.X + 1, c.Y}
 }
 
 // Around gives back the discrete coordinates around the current vector
 // the order of the vectors is: UP, LEFT, DOWN, RIGHT
-func (c Vec2) Around() []Vec2 {
-	return []Vec2{
+func (c Vector2[T]) Around() []Vector2[T] {
+	return []Vector2[T]{
 		c.Up(),
 		c.Left(),
 		c.Down(),
@@ -39,33 +75,78 @@ func (c Vec2) Around() []Vec2 {
 	}
 }
 
+// Neighbors8 gives back the coordinates around the current vector, including the diagonal ones.
+// The order of the vectors is clockwise starting from the top left one.
+func (c Vector2[T]) Neighbors8() []Vector2[T] {
+	return []Vector2[T]{
+		{c.X - 1, c.Y - 1},
+		{c.X, c.Y - 1},
+		{c.X + 1, c.Y - 1},
+		{c.X + 1, c.Y},
+		{c.X + 1, c.Y + 1},
+		{c.X, c.Y + 1},
+		{c.X - 1, c.Y + 1},
+		{c.X - 1, c.Y},
+	}
+}
+
 // Add translates the vector using another vector.
-func (c Vec2) Add(a *Vec2) Vec2 {
-	return Vec2{c.X + a.X, c.Y + a.Y}
+func (c Vector2[T]) Add(a *Vector2[T]) Vector2[T] {
+	return Vector2[T]{c.X + a.X, c.Y + a.Y}
 }
 
 // Subtract calculates the difference between two vectors.
-func (c Vec2) Subtract(a *Vec2) Vec2 {
-	return Vec2{c.X - a.X, c.Y - a.Y}
+func (c Vector2[T]) Subtract(a *Vector2[T]) Vector2[T] {
+	return Vector2[T]{c.X - a.X, c.Y - a.Y}
+}
+
+// Neg gives back the vector pointing in the opposite direction.
+func (c Vector2[T]) Neg() Vector2[T] {
+	return Vector2[T]{-c.X, -c.Y}
+}
+
+// Scale multiplies both coordinates of the vector by the given scalar.
+func (c Vector2[T]) Scale(i T) Vector2[T] {
+	return Vector2[T]{X: i * c.X, Y: i * c.Y}
+}
+
+// Dot calculates the dot product of two vectors.
+func (c Vector2[T]) Dot(a *Vector2[T]) T {
+	return c.X*a.X + c.Y*a.Y
+}
+
+// Cross calculates the Z coordinate of the cross product of two vectors.
+// It is positive if the other vector points to the right of the current one, as Y grows downwards.
+func (c Vector2[T]) Cross(a *Vector2[T]) T {
+	return c.X*a.Y - c.Y*a.X
 }
 
 // RotateLeft rotates the vector 90 degrees to the left.
-func (c Vec2) RotateLeft() Vec2 {
-	return Vec2{
-		X: c.X*int(math.Cos(3*math.Pi/2)) - c.Y*int(math.Sin(3*math.Pi/2)),
-		Y: c.X*int(math.Sin(3*math.Pi/2)) + c.Y*int(math.Cos(3*math.Pi/2)),
-	}
+func (c Vector2[T]) RotateLeft() Vector2[T] {
+	return Vector2[T]{X: c.Y, Y: -c.X}
 }
 
 // RotateRight rotates the vector 90 degrees to the right.
-func (c Vec2) RotateRight() Vec2 {
-	return Vec2{
-		X: c.X*int(math.Cos(math.Pi/2)) - c.Y*int(math.Sin(math.Pi/2)),
-		Y: c.X*int(math.Sin(math.Pi/2)) + c.Y*int(math.Cos(math.Pi/2)),
-	}
+func (c Vector2[T]) RotateRight() Vector2[T] {
+	return Vector2[T]{X: -c.Y, Y: c.X}
+}
+
+// ManhattanDistance calculates the sum of the absolute differences of the coordinates.
+func (c Vector2[T]) ManhattanDistance(a *Vector2[T]) T {
+	return abs(c.X-a.X) + abs(c.Y-a.Y)
+}
+
+// ChebyshevDistance calculates the largest absolute difference of the coordinates.
+func (c Vector2[T]) ChebyshevDistance(a *Vector2[T]) T {
+	return max(abs(c.X-a.X), abs(c.Y-a.Y))
+}
+
+// Extend projects the vector into the 3D space at the given Z coordinate.
+func (c Vector2[T]) Extend(z T) Vector3[T] {
+	return Vector3[T]{X: c.X, Y: c.Y, Z: z}
 }
 
-// Multiply multiplies both coordinates of the vector by the given scalar.
-func (c Vec2) Multiply(i int) Vec2 {
-	return Vec2{X: i * c.X, Y: i * c.Y}
+// String formats the vector as (X, Y).
+func (c Vector2[T]) String() string {
+	return fmt.Sprintf("(%d, %d)", c.X, c.Y)
 }
@@ -0,0 +1,68 @@
+package types_test
+
+import (
+	"errors"
+	"github.com/wlchs/advent_of_code_go_template/types"
+	"testing"
+)
+
+func TestVec2Rotate(t *testing.T) {
+	t.Parallel()
+
+	for i, d := range types.Directions {
+		next := types.Directions[(i+1)%len(types.Directions)]
+		if r := d.RotateRight(); r != next {
+			t.Errorf("expected %v rotated right to be %v, but got %v instead", d, next, r)
+		}
+		if l := next.RotateLeft(); l != d {
+			t.Errorf("expected %v rotated left to be %v, but got %v instead", next, d, l)
+		}
+		if c := d.Cross(&next); c != 1 {
+			t.Errorf("expected a right turn from %v to %v, but got %d instead", d, next, c)
+		}
+	}
+
+	v := types.Vector2[int64]{X: 3_000_000_000, Y: -7}
+	if r := v.RotateRight().RotateRight(); r != v.Neg() {
+		t.Errorf("expected a half turn to negate %v, but got %v instead", v, r)
+	}
+}
+
+func TestVec2Algebra(t *testing.T) {
+	t.Parallel()
+
+	a := types.Vec2{X: 1, Y: -2}
+	b := types.Vec2{X: -3, Y: 4}
+	if d := a.ManhattanDistance(&b); d != 10 {
+		t.Errorf("expected a Manhattan distance of 10, but got %d instead", d)
+	}
+	if d := a.ChebyshevDistance(&b); d != 6 {
+		t.Errorf("expected a Chebyshev distance of 6, but got %d instead", d)
+	}
+	if d := a.Dot(&b); d != -11 {
+		t.Errorf("expected a dot product of -11, but got %d instead", d)
+	}
+	if s := a.Scale(3); s != (types.Vec2{X: 3, Y: -6}) {
+		t.Errorf("unexpected scaled vector %v", s)
+	}
+	if p := a.Extend(5).Project(); p != a {
+		t.Errorf("expected the projection to give back %v, but got %v instead", a, p)
+	}
+	if s := a.String(); s != "(1, -2)" {
+		t.Errorf("unexpected string %q", s)
+	}
+}
+
+func TestParseDirection(t *testing.T) {
+	t.Parallel()
+
+	tests := map[rune]types.Vec2{'U': types.N, '^': types.N, 'R': types.E, '>': types.E, 'D': types.S, 'v': types.S, 'L': types.W, '<': types.W}
+	for r, expected := range tests {
+		if d, err := types.ParseDirection(r); err != nil || d != expected {
+			t.Errorf("expected %q to be %v, but got %v, %v instead", r, expected, d, err)
+		}
+	}
+	if _, err := types.ParseDirection('x'); !errors.Is(err, types.ErrInvalidDirection) {
+		t.Errorf("expected ErrInvalidDirection, but got %v instead", err)
+	}
+}
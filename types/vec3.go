@@ -1,8 +1,93 @@
 package types
 
-// Vec3 defines the X Y and Z values of a 3D vector
-type Vec3 struct {
-	X int
-	Y int
-	Z int
+import "fmt"
+
+// Vector3 defines the X Y and Z values of a 3D vector with coordinates of any integer type
+type Vector3[T Integer] struct {
+	X T
+	Y T
+	Z T
+}
+
+// Vec3 is the 3D vector of int coordinates used by most of the challenges
+type Vec3 = Vector3[int]
+
+// Add translates the vector using another vector.
+func (c Vector3[T]) Add(a *Vector3[T]) Vector3[T] {
+	return Vector3[T]{c.X + a.X, c.Y + a.Y, c.Z + a.Z}
+}
+
+// Subtract calculates the difference between two vectors.
+func (c Vector3[T]) Subtract(a *Vector3[T]) Vector3[T] {
+	return Vector3[T]{c.X - a.X, c.Y - a.Y, c.Z - a.Z}
+}
+
+// Neg gives back the vector pointing in the opposite direction.
+func (c Vector3[T]) Neg() Vector3[T] {
+	return Vector3[T]{-c.X, -c.Y, -c.Z}
+}
+
+// Scale multiplies every coordinate of the vector by the given scalar.
+func (c Vector3[T]) Scale(i T) Vector3[T] {
+	return Vector3[T]{i * c.X, i * c.Y, i * c.Z}
+}
+
+// Dot calculates the dot product of two vectors.
+func (c Vector3[T]) Dot(a *Vector3[T]) T {
+	return c.X*a.X + c.Y*a.Y + c.Z*a.Z
+}
+
+// Cross calculates the cross product of two vectors.
+func (c Vector3[T]) Cross(a *Vector3[T]) Vector3[T] {
+	return Vector3[T]{
+		X: c.Y*a.Z - c.Z*a.Y,
+		Y: c.Z*a.X - c.X*a.Z,
+		Z: c.X*a.Y - c.Y*a.X,
+	}
+}
+
+// ManhattanDistance calculates the sum of the absolute differences of the coordinates.
+func (c Vector3[T]) ManhattanDistance(a *Vector3[T]) T {
+	return abs(c.X-a.X) + abs(c.Y-a.Y) + abs(c.Z-a.Z)
+}
+
+// ChebyshevDistance calculates the largest absolute difference of the coordinates.
+func (c Vector3[T]) ChebyshevDistance(a *Vector3[T]) T {
+	return max(abs(c.X-a.X), abs(c.Y-a.Y), abs(c.Z-a.Z))
+}
+
+// Neighbors6 gives back the coordinates sharing a face with the current vector.
+func (c Vector3[T]) Neighbors6() []Vector3[T] {
+	return c.neighbors(1)
+}
+
+// Neighbors18 gives back the coordinates sharing a face or an edge with the current vector.
+func (c Vector3[T]) Neighbors18() []Vector3[T] {
+	return c.neighbors(2)
+}
+
+// neighbors gives back the coordinates around the current vector which differ in at most the given number of coordinates
+func (c Vector3[T]) neighbors(changed int) []Vector3[T] {
+	var res []Vector3[T]
+	for dx := T(-1); dx <= 1; dx++ {
+		for dy := T(-1); dy <= 1; dy++ {
+			for dz := T(-1); dz <= 1; dz++ {
+				d := Vector3[T]{dx, dy, dz}
+				if n := int(abs(dx) + abs(dy) + abs(dz)); n > 0 && n <= changed {
+					res = append(res, c.Add(&d))
+				}
+			}
+		}
+	}
+	return res
+}
+
+// Project drops the Z coordinate of the vector.
+func (c Vector3[T]) Project() Vector2[T] {
+	return Vector2[T]{X: c.X, Y: c.Y}
+}
+
+// String formats the vector as (X, Y, Z).
+func (c Vector3[T]) String() string {
+	return fmt.Sprintf("(%d, %d, %d)", c.X, c.Y, c.Z)
 }
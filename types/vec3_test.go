@@ -0,0 +1,46 @@
+package types_test
+
+import (
+	"github.com/wlchs/advent_of_code_go_template/types"
+	"testing"
+)
+
+func TestVec3Neighbors(t *testing.T) {
+	t.Parallel()
+
+	v := types.Vec3{X: 1, Y: 2, Z: 3}
+	faces := v.Neighbors6()
+	if len(faces) != 6 {
+		t.Fatalf("expected 6 face neighbours, but got %v instead", faces)
+	}
+	for _, n := range faces {
+		if d := v.ManhattanDistance(&n); d != 1 {
+			t.Errorf("expected %v to share a face with %v", n, v)
+		}
+	}
+	edges := v.Neighbors18()
+	if len(edges) != 18 {
+		t.Fatalf("expected 18 face and edge neighbours, but got %v instead", edges)
+	}
+	for _, n := range edges {
+		if d := v.ManhattanDistance(&n); d > 2 || v.ChebyshevDistance(&n) != 1 {
+			t.Errorf("expected %v to share a face or an edge with %v", n, v)
+		}
+	}
+}
+
+func TestVec3Algebra(t *testing.T) {
+	t.Parallel()
+
+	x := types.Vector3[int64]{X: 1}
+	y := types.Vector3[int64]{Y: 1}
+	if z := x.Cross(&y); z != (types.Vector3[int64]{Z: 1}) {
+		t.Errorf("expected x cross y to be the z unit vector, but got %v instead", z)
+	}
+	if d := x.Dot(&y); d != 0 {
+		t.Errorf("expected perpendicular vectors, but got a dot product of %d", d)
+	}
+	if s := x.Scale(2).Neg().String(); s != "(-2, 0, 0)" {
+		t.Errorf("unexpected string %q", s)
+	}
+}
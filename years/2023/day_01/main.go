@@ -1,12 +1,15 @@
 package day_01
 
 import (
-	"fmt"
+	"github.com/wlchs/advent_of_code_go_template/internal/registry"
 	"regexp"
 	"strconv"
 	"strings"
 )
 
+// Solver of the daily challenge, registered in the solver registry
+var Solver = registry.Register(registry.NewSolver(2023, 1, "Trebuchet?!", Part1, Part2))
+
 var mapping = map[string]int{
 	"1":     1,
 	"one":   1,
@@ -28,16 +31,6 @@ var mapping = map[string]int{
 	"nine":  9,
 }
 
-// Run function of the daily challenge
-func Run(input []string, mode int) {
-	if mode == 1 || mode == 3 {
-		fmt.Printf("Part one: %v\n", Part1(input))
-	}
-	if mode == 2 || mode == 3 {
-		fmt.Printf("Part two: %v\n", Part2(input))
-	}
-}
-
 // Part1 solves the first part of the exercise
 func Part1(input []string) string {
 	sum := 0
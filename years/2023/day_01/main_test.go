@@ -0,0 +1,13 @@
+package day_01_test
+
+import (
+	"github.com/wlchs/advent_of_code_go_template/internal/harness"
+	"github.com/wlchs/advent_of_code_go_template/years/2023/day_01"
+	"testing"
+)
+
+func TestExamples(t *testing.T) {
+	t.Parallel()
+
+	harness.Test(t, day_01.Solver, ".")
+}
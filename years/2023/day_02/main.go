@@ -1,11 +1,14 @@
 package day_02
 
 import (
-	"fmt"
+	"github.com/wlchs/advent_of_code_go_template/internal/registry"
 	"strconv"
 	"strings"
 )
 
+// Solver of the daily challenge, registered in the solver registry
+var Solver = registry.Register(registry.NewSolver(2023, 2, "Cube Conundrum", Part1, Part2))
+
 // set represents a set of the game
 type set struct {
 	red   int
@@ -13,16 +16,6 @@ type set struct {
 	blue  int
 }
 
-// Run function of the daily challenge
-func Run(input []string, mode int) {
-	if mode == 1 || mode == 3 {
-		fmt.Printf("Part one: %v\n", Part1(input))
-	}
-	if mode == 2 || mode == 3 {
-		fmt.Printf("Part two: %v\n", Part2(input))
-	}
-}
-
 // Part1 solves the first part of the exercise
 func Part1(input []string) string {
 	sum := 0
@@ -1,20 +1,13 @@
 package day_03
 
 import (
-	"fmt"
+	"github.com/wlchs/advent_of_code_go_template/internal/registry"
 	"slices"
 	"strconv"
 )
 
-// Run function of the daily challenge
-func Run(input []string, mode int) {
-	if mode == 1 || mode == 3 {
-		fmt.Printf("Part one: %v\n", Part1(input))
-	}
-	if mode == 2 || mode == 3 {
-		fmt.Printf("Part two: %v\n", Part2(input))
-	}
-}
+// Solver of the daily challenge, registered in the solver registry
+var Solver = registry.Register(registry.NewSolver(2023, 3, "Gear Ratios", Part1, Part2))
 
 // Part1 solves the first part of the exercise
 func Part1(input []string) string {
@@ -1,7 +1,7 @@
 package day_04
 
 import (
-	"fmt"
+	"github.com/wlchs/advent_of_code_go_template/internal/registry"
 	"math"
 	"regexp"
 	"slices"
@@ -9,6 +9,9 @@ import (
 	"strings"
 )
 
+// Solver of the daily challenge, registered in the solver registry
+var Solver = registry.Register(registry.NewSolver(2023, 4, "Scratchcards", Part1, Part2))
+
 // card struct representing a scratchcard
 type card struct {
 	index   int
@@ -16,16 +19,6 @@ type card struct {
 	all     []int
 }
 
-// Run function of the daily challenge
-func Run(input []string, mode int) {
-	if mode == 1 || mode == 3 {
-		fmt.Printf("Part one: %v\n", Part1(input))
-	}
-	if mode == 2 || mode == 3 {
-		fmt.Printf("Part two: %v\n", Part2(input))
-	}
-}
-
 // Part1 solves the first part of the exercise
 func Part1(input []string) string {
 	sum := 0
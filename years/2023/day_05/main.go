@@ -0,0 +1,81 @@
+package day_05
+
+import (
+	"github.com/wlchs/advent_of_code_go_template/internal/registry"
+	"github.com/wlchs/advent_of_code_go_template/types"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Solver of the daily challenge, registered in the solver registry
+var Solver = registry.Register(registry.NewSolver(2023, 5, "If You Give A Seed A Fertilizer", Part1, Part2))
+
+// Part1 solves the first part of the exercise
+func Part1(input []string) string {
+	seeds := getInitialSeeds(input)
+	return strconv.Itoa(lowestLocation(getIntervalMapping(input), seeds))
+}
+
+// Part2 solves the second part of the exercise
+func Part2(input []string) string {
+	seeds := getInitialSeedIntervals(input)
+	return strconv.Itoa(lowestLocation(getIntervalMapping(input), seeds))
+}
+
+// lowestLocation maps the seeds to their locations and finds the smallest one
+func lowestLocation(m types.Mapping, seeds types.IntervalSet) int {
+	l, ok := m.Apply(seeds).Min()
+	if !ok {
+		panic("no seeds found")
+	}
+	return l
+}
+
+// getInitialSeeds finds the initial seeds from the input
+func getInitialSeeds(input []string) types.IntervalSet {
+	splitter := regexp.MustCompile("\\s+")
+	seeds := splitter.Split(strings.Split(input[0], ": ")[1], -1)
+	var s []types.Interval
+	for _, seed := range seeds {
+		i, _ := strconv.Atoi(seed)
+		s = append(s, types.Closed(i, i))
+	}
+	return types.NewIntervalSet(s...)
+}
+
+// getInitialSeedIntervals finds the initial seed intervals from the input
+func getInitialSeedIntervals(input []string) types.IntervalSet {
+	splitter := regexp.MustCompile("\\s+")
+	seeds := splitter.Split(strings.Split(input[0], ": ")[1], -1)
+	var it []types.Interval
+	for i := 0; i < len(seeds); i += 2 {
+		source, _ := strconv.Atoi(seeds[i])
+		length, _ := strconv.Atoi(seeds[i+1])
+		it = append(it, types.HalfOpen(source, source+length))
+	}
+	return types.NewIntervalSet(it...)
+}
+
+// getIntervalMapping reads the mapping layers and composes them into a single mapping from seeds to locations
+func getIntervalMapping(input []string) types.Mapping {
+	var m types.Mapping
+	var layer []types.OffsetRange
+	for _, s := range input[2:] {
+		if s == "" {
+			m = m.Then(types.NewMapping(layer...))
+			layer = nil
+		} else if !strings.Contains(s, "map") {
+			splitter := regexp.MustCompile("\\s+")
+			row := splitter.Split(s, -1)
+			target, _ := strconv.Atoi(row[0])
+			source, _ := strconv.Atoi(row[1])
+			length, _ := strconv.Atoi(row[2])
+			layer = append(layer, types.OffsetRange{
+				Interval: types.HalfOpen(source, source+length),
+				Offset:   target - source,
+			})
+		}
+	}
+	return m.Then(types.NewMapping(layer...))
+}
@@ -1,7 +1,7 @@
 package day_07
 
 import (
-	"fmt"
+	"github.com/wlchs/advent_of_code_go_template/internal/registry"
 	"github.com/wlchs/advent_of_code_go_template/utils"
 	"slices"
 	"sort"
@@ -9,6 +9,9 @@ import (
 	"strings"
 )
 
+// Solver of the daily challenge, registered in the solver registry
+var Solver = registry.Register(registry.NewSolver(2023, 7, "Camel Cards", Part1, Part2))
+
 // hand struct representing a set of cards
 type hand struct {
 	cards [5]uint8
@@ -94,16 +97,6 @@ func (h hand) isStronger(other *hand, order [13]uint8, joker bool) bool {
 	panic("identical cards")
 }
 
-// Run function of the daily challenge
-func Run(input []string, mode int) {
-	if mode == 1 || mode == 3 {
-		fmt.Printf("Part one: %v\n", Part1(input))
-	}
-	if mode == 2 || mode == 3 {
-		fmt.Printf("Part two: %v\n", Part2(input))
-	}
-}
-
 // Part1 solves the first part of the exercise
 func Part1(input []string) string {
 	var cardOrder = [13]uint8{'A', 'K', 'Q', 'J', 'T', '9', '8', '7', '6', '5', '4', '3', '2'}
@@ -0,0 +1,175 @@
+package day_08
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/wlchs/advent_of_code_go_template/internal/registry"
+	"github.com/wlchs/advent_of_code_go_template/mathx"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Solver of the daily challenge, registered in the solver registry
+var Solver = registry.Register(registry.NewContextSolver(2023, 8, "Haunted Wasteland", registry.IgnoreContext(Part1), Part2))
+
+// Part1 solves the first part of the exercise
+func Part1(input []string) string {
+	instructions, nodes := getNodes(input)
+	count := countStepsFromAToZ("AAA", nodes, instructions)
+	return strconv.Itoa(count)
+}
+
+// Part2 solves the second part of the exercise
+func Part2(ctx context.Context, input []string) (string, error) {
+	instructions, nodes := getNodes(input)
+	count, err := countGhostSteps(ctx, nodes, instructions)
+	if err != nil {
+		return "", err
+	}
+	return strconv.Itoa(count), nil
+}
+
+// getNodes retrieves the network from the input and returns a graph as a map.
+// The key of the map is the node and the value contains the edges.
+func getNodes(input []string) (string, map[string][]string) {
+	re := regexp.MustCompile("\\w{3}")
+	goal := input[0]
+	m := map[string][]string{}
+	for _, s := range input[2:] {
+		match := re.FindAllString(s, -1)
+		m[match[0]] = []string{match[1], match[2]}
+	}
+	return goal, m
+}
+
+// countStepsFromAToZ start iterating over the input instructions starting from a node ending with "A".
+// The iteration stops when a "Z" node is reached
+func countStepsFromAToZ(current string, nodes map[string][]string, instructions string) int {
+	steps := 0
+	for ; !strings.HasSuffix(current, "Z"); steps++ {
+		instruction := instructions[steps%len(instructions)]
+		if instruction == 'L' {
+			current = nodes[current][0]
+		} else {
+			current = nodes[current][1]
+		}
+	}
+	return steps
+}
+
+// countGhostSteps start iterating over the input instructions starting from every input ending with "A".
+// The iteration stops when every parallel iteration stands on a node ending with "Z", and fails if the ghosts never do
+// so or if the context is done.
+func countGhostSteps(ctx context.Context, nodes map[string][]string, instructions string) (int, error) {
+	var ghosts []ghostCycle
+	for node := range nodes {
+		if strings.HasSuffix(node, "A") {
+			g, err := findCycle(ctx, node, nodes, instructions)
+			if err != nil {
+				return 0, err
+			}
+			ghosts = append(ghosts, g)
+		}
+	}
+
+	// before every ghost enters its cycle, the steps are checked one by one
+	start := 0
+	for _, g := range ghosts {
+		start = max(start, g.start)
+	}
+	for steps := 0; steps < start; steps++ {
+		if allOnZ(ghosts, steps) {
+			return steps, nil
+		}
+	}
+
+	// afterwards each combination of the "Z" nodes within the cycles gives a system of congruences
+	best := -1
+	var combine func(i int, congruences []mathx.Congruence) error
+	combine = func(i int, congruences []mathx.Congruence) error {
+		if i == len(ghosts) {
+			c, err := mathx.CRT(congruences...)
+			if errors.Is(err, mathx.ErrNoSolution) {
+				return nil
+			} else if err != nil {
+				return err
+			}
+			if steps := c.First(start); best < 0 || steps < best {
+				best = steps
+			}
+			return nil
+		}
+		for _, steps := range ghosts[i].zSteps {
+			if steps >= ghosts[i].start {
+				congruence := mathx.Congruence{Residue: steps, Modulus: ghosts[i].length}
+				if err := combine(i+1, append(congruences, congruence)); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+	if err := combine(0, nil); err != nil {
+		return 0, err
+	}
+	if best < 0 {
+		return 0, fmt.Errorf("the ghosts never stand on Z nodes at the same time: %w", mathx.ErrNoSolution)
+	}
+	return best, nil
+}
+
+// ghostCycle describes the path of a single ghost: the steps after which it stands on a node ending with "Z",
+// until the path starts repeating with the given length from the given step.
+type ghostCycle struct {
+	zSteps []int
+	start  int
+	length int
+}
+
+// findCycle follows the instructions from the given node until the same node is reached at the same instruction, or
+// until the context is done.
+func findCycle(ctx context.Context, current string, nodes map[string][]string, instructions string) (ghostCycle, error) {
+	type state struct {
+		node        string
+		instruction int
+	}
+	var g ghostCycle
+	seen := map[state]int{}
+	for steps := 0; ; steps++ {
+		if err := ctx.Err(); err != nil {
+			return ghostCycle{}, err
+		}
+		s := state{node: current, instruction: steps % len(instructions)}
+		if first, ok := seen[s]; ok {
+			g.start = first
+			g.length = steps - first
+			return g, nil
+		}
+		seen[s] = steps
+		if strings.HasSuffix(current, "Z") {
+			g.zSteps = append(g.zSteps, steps)
+		}
+		if instructions[s.instruction] == 'L' {
+			current = nodes[current][0]
+		} else {
+			current = nodes[current][1]
+		}
+	}
+}
+
+// allOnZ checks whether every ghost stands on a node ending with "Z" after the given number of steps
+func allOnZ(ghosts []ghostCycle, steps int) bool {
+	for _, g := range ghosts {
+		s := steps
+		if s >= g.start {
+			s = g.start + (s-g.start)%g.length
+		}
+		if !slices.Contains(g.zSteps, s) {
+			return false
+		}
+	}
+	return true
+}
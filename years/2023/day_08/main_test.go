@@ -0,0 +1,35 @@
+package day_08_test
+
+import (
+	"context"
+	"errors"
+	"github.com/wlchs/advent_of_code_go_template/internal/harness"
+	"github.com/wlchs/advent_of_code_go_template/mathx"
+	"github.com/wlchs/advent_of_code_go_template/years/2023/day_08"
+	"testing"
+)
+
+func TestExamples(t *testing.T) {
+	t.Parallel()
+
+	harness.Test(t, day_08.Solver, ".")
+}
+
+func TestGhostsNeverAligned(t *testing.T) {
+	t.Parallel()
+
+	// the first ghost stands on 11Z after odd, the second one on 22Z after even numbers of steps
+	input := []string{
+		"L",
+		"",
+		"11A = (11Z, 11Z)",
+		"11Z = (11B, 11B)",
+		"11B = (11Z, 11Z)",
+		"22A = (22B, 22B)",
+		"22B = (22Z, 22Z)",
+		"22Z = (22B, 22B)",
+	}
+	if answer, err := day_08.Part2(context.Background(), input); !errors.Is(err, mathx.ErrNoSolution) {
+		t.Errorf("expected ErrNoSolution, but got %q, %v instead", answer, err)
+	}
+}
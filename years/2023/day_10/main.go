@@ -0,0 +1,159 @@
+package day_10
+
+import (
+	"github.com/wlchs/advent_of_code_go_template/internal/registry"
+	"github.com/wlchs/advent_of_code_go_template/types"
+	"slices"
+	"strconv"
+)
+
+// Solver of the daily challenge, registered in the solver registry
+var Solver = registry.Register(registry.NewSolver(2023, 10, "Pipe Maze", Part1, Part2))
+
+var east = []int32{'-', 'L', 'F', 'S'}
+var north = []int32{'|', 'L', 'J', 'S'}
+var west = []int32{'-', 'J', '7', 'S'}
+var south = []int32{'|', '7', 'F', 'S'}
+
+// Part1 solves the first part of the exercise
+func Part1(input []string) string {
+	m, s := readMap(input)
+	return strconv.Itoa(getFurthestPoint(m, &s))
+}
+
+// Part2 solves the second part of the exercise
+func Part2(input []string) string {
+	m, s := readMap(input)
+	return strconv.Itoa(getEnclosedPoints(m, &s))
+}
+
+// readMap reads the pipes of the input into a map surrounded by a ring of ground tiles, so that every tile outside the
+// loop is connected to the top left corner
+// it gives back the map and the starting position
+func readMap(input []string) (*types.Grid[int32], types.Vec2) {
+	pipes := types.ParseGrid(input)
+	m := types.NewGrid[int32](pipes.Width()+2, pipes.Height()+2)
+	m.Each(func(v types.Vec2, _ int32) {
+		m.Set(v, '.')
+	})
+	pipes.Each(func(v types.Vec2, c int32) {
+		m.Set(types.Vec2{X: v.X + 1, Y: v.Y + 1}, c)
+	})
+	s, ok := m.Find(func(c int32) bool {
+		return c == 'S'
+	})
+	if !ok {
+		panic("no starting position found!")
+	}
+	return m, s
+}
+
+// getFurthestPoint follows the pipes starting from the given coordinates and calculates the number of steps in which the furthest possible
+// point can be reached
+func getFurthestPoint(m *types.Grid[int32], s *types.Vec2) int {
+	for _, neighbour := range s.Around() {
+		l, ok := followPipe(m, s, &neighbour)
+		if ok {
+			return len(l) / 2
+		}
+	}
+	panic("no loop found!")
+}
+
+// followPipe tries to follow the pipes of the input.
+// The search terminates if:
+// - the pipes no longer continue
+// - a loop is encountered
+func followPipe(m *types.Grid[int32], previous *types.Vec2, current *types.Vec2) ([]types.Vec2, bool) {
+	if !transitionAllowed(m, previous, current) {
+		return nil, false
+	}
+	if m.At(*current) == 'S' {
+		return []types.Vec2{*current}, true
+	}
+	for _, neighbor := range current.Around() {
+		if neighbor == *previous {
+			continue
+		}
+		next, ok := followPipe(m, current, &neighbor)
+		if ok {
+			return append(next, *current), true
+		}
+	}
+	return nil, false
+}
+
+// transitionAllowed checks whether a transition between the given two coordinates of the map is allowed or not
+func transitionAllowed(m *types.Grid[int32], s *types.Vec2, t *types.Vec2) bool {
+	source := m.At(*s)
+	target := m.At(*t)
+	switch t.X - s.X {
+	case 1:
+		return slices.Contains(east, source) && slices.Contains(west, target)
+	case -1:
+		return slices.Contains(west, source) && slices.Contains(east, target)
+	}
+	switch t.Y - s.Y {
+	case 1:
+		return slices.Contains(south, source) && slices.Contains(north, target)
+	case -1:
+		return slices.Contains(north, source) && slices.Contains(south, target)
+	}
+	return false
+}
+
+// getEnclosedPoints counts the number of ground (.) tiles enclosed by the pipe loop
+func getEnclosedPoints(m *types.Grid[int32], s *types.Vec2) int {
+	for _, neighbour := range s.Around() {
+		l, ok := followPipe(m, s, &neighbour)
+		if ok {
+			return getEnclosedPointsByLoop(m, l)
+		}
+	}
+	panic("no loop found!")
+}
+
+// getEnclosedPointsByLoop gets the number points in the map enclosed by the provided loop
+func getEnclosedPointsByLoop(m *types.Grid[int32], loop []types.Vec2) int {
+	loopLength := len(loop)
+	for i := 0; i < loopLength; i++ {
+		current := loop[i]
+		next := loop[(i+1)%loopLength]
+		previous := loop[((i-1%loopLength)+loopLength)%loopLength]
+		nextDir := next.Subtract(&current)
+		previousDir := current.Subtract(&previous)
+		markSides(m, loop, &current, &nextDir)
+		markSides(m, loop, &current, &previousDir)
+	}
+	outside := m.At(types.Vec2{})
+	var inside int32
+	if outside == 'A' {
+		inside = 'B'
+	} else {
+		inside = 'A'
+	}
+	return len(m.FindAll(func(tile int32) bool {
+		return tile == inside
+	}))
+}
+
+// markSides marks every node on each side of the current tile based on the orientation.
+// Nodes on the left are marked with "A", nodes on the right with "B"
+func markSides(m *types.Grid[int32], loop []types.Vec2, current *types.Vec2, direction *types.Vec2) {
+	side := direction.RotateRight()
+	left := current.Add(&side)
+	right := current.Subtract(&side)
+	mark(m, loop, &left, 'A')
+	mark(m, loop, &right, 'B')
+}
+
+// mark recursively marks the fields reachable from the current position without running out of bounds encountering a pipe of the loop
+func mark(m *types.Grid[int32], loop []types.Vec2, c *types.Vec2, side int32) {
+	if !m.In(*c) || slices.Contains(loop, *c) || m.At(*c) == side {
+		return
+	}
+	m.Set(*c, side)
+	for _, neighbor := range m.Neighbors4(*c) {
+		mark(m, loop, &neighbor, side)
+	}
+}
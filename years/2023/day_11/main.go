@@ -0,0 +1,67 @@
+package day_11
+
+import (
+	"github.com/wlchs/advent_of_code_go_template/internal/registry"
+	"github.com/wlchs/advent_of_code_go_template/types"
+	"math"
+	"slices"
+	"strconv"
+)
+
+// Solver of the daily challenge, registered in the solver registry
+var Solver = registry.Register(registry.NewSolver(2023, 11, "Cosmic Expansion", Part1, Part2))
+
+// Part1 solves the first part of the exercise
+func Part1(input []string) string {
+	sum := 0
+	g := findGalaxies(input, 2)
+	for _, g1 := range g {
+		for _, g2 := range g {
+			dx := float64(g2.X - g1.X)
+			dy := float64(g2.Y - g1.Y)
+			sum += int(math.Abs(dx) + math.Abs(dy))
+		}
+	}
+	return strconv.Itoa(sum / 2)
+}
+
+// Part2 solves the second part of the exercise
+func Part2(input []string) string {
+	sum := 0
+	g := findGalaxies(input, 1000000)
+	for _, g1 := range g {
+		for _, g2 := range g {
+			dx := float64(g2.X - g1.X)
+			dy := float64(g2.Y - g1.Y)
+			sum += int(math.Abs(dx) + math.Abs(dy))
+		}
+	}
+	return strconv.Itoa(sum / 2)
+}
+
+// findGalaxies iterates over the input and finds the corrected location of each galaxy
+func findGalaxies(input []string, o int) []types.Vec2 {
+	m := types.ParseGrid(input)
+	var offset types.Vec2
+	var g []types.Vec2
+	for y := 0; y < m.Height(); y++ {
+		if isEmpty(m.Row(y)) {
+			offset.Y += o - 1
+		}
+		offset.X = 0
+		for x := 0; x < m.Width(); x++ {
+			if isEmpty(m.Column(x)) {
+				offset.X += o - 1
+			}
+			if m.At(types.Vec2{X: x, Y: y}) == '#' {
+				g = append(g, types.Vec2{X: x + offset.X, Y: y + offset.Y})
+			}
+		}
+	}
+	return g
+}
+
+// isEmpty checks whether the given row or column of the input contains no galaxies
+func isEmpty(line []int32) bool {
+	return !slices.Contains(line, '#')
+}
@@ -1,21 +1,14 @@
 package day_12
 
 import (
-	"fmt"
+	"github.com/wlchs/advent_of_code_go_template/internal/registry"
 	"github.com/wlchs/advent_of_code_go_template/utils"
 	"strconv"
 	"strings"
 )
 
-// Run function of the daily challenge
-func Run(input []string, mode int) {
-	if mode == 1 || mode == 3 {
-		fmt.Printf("Part one: %v\n", Part1(input))
-	}
-	if mode == 2 || mode == 3 {
-		fmt.Printf("Part two: %v\n", Part2(input))
-	}
-}
+// Solver of the daily challenge, registered in the solver registry
+var Solver = registry.Register(registry.NewSolver(2023, 12, "Hot Springs", Part1, Part2))
 
 // Part1 solves the first part of the exercise
 func Part1(input []string) string {
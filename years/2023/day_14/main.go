@@ -0,0 +1,84 @@
+package day_14
+
+import (
+	"context"
+	"github.com/wlchs/advent_of_code_go_template/internal/registry"
+	"github.com/wlchs/advent_of_code_go_template/types"
+	"strconv"
+	"strings"
+)
+
+// Solver of the daily challenge, registered in the solver registry
+var Solver = registry.Register(registry.NewContextSolver(2023, 14, "Parabolic Reflector Dish", registry.IgnoreContext(Part1), Part2))
+
+// Part1 solves the first part of the exercise
+func Part1(input []string) string {
+	m := types.ParseGrid(input)
+	roll(m, 0)
+	return strconv.Itoa(load(m))
+}
+
+// Part2 solves the second part of the exercise
+func Part2(ctx context.Context, input []string) (string, error) {
+	m := types.ParseGrid(input)
+	if err := rollAround(ctx, m, 1000000000); err != nil {
+		return "", err
+	}
+	return strconv.Itoa(load(m)), nil
+}
+
+// roll tries to roll every stone in the input as far as possible in the given direction
+// the directions are indexed in the order of types.Vec2.Around
+func roll(m *types.Grid[int32], dir int) {
+	shouldRepeat := false
+	m.Each(func(coords types.Vec2, field int32) {
+		around := coords.Around()
+		if field == 'O' && m.At(around[dir]) == '.' {
+			m.Set(coords, '.')
+			m.Set(around[dir], 'O')
+			shouldRepeat = true
+		}
+	})
+	if shouldRepeat {
+		roll(m, dir)
+	}
+}
+
+// rollAround tries to roll every stone in the input in a rotating fashion
+// it stops early if the context is done
+func rollAround(ctx context.Context, m *types.Grid[int32], cycles int) error {
+	cache := map[string][]int{}
+	for i := 0; i < cycles; i++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		l, ok := cache[key(m)]
+		if ok {
+			return rollAround(ctx, m, (cycles-i+1)%(i-l[0])-1)
+		}
+		k := key(m)
+		for dir := 0; dir < 4; dir++ {
+			roll(m, dir)
+		}
+		cache[k] = []int{i, load(m)}
+	}
+	return nil
+}
+
+// load calculates the overall load on the north support beams
+func load(m *types.Grid[int32]) int {
+	sum := 0
+	for _, coords := range m.FindAll(func(field int32) bool { return field == 'O' }) {
+		sum += m.Height() - coords.Y
+	}
+	return sum
+}
+
+// key generates a lookup key for memorization
+func key(m *types.Grid[int32]) string {
+	var sb strings.Builder
+	for _, row := range m.Rows() {
+		sb.WriteString(string(row))
+	}
+	return sb.String()
+}
@@ -1,29 +1,22 @@
 package day_15
 
 import (
-	"fmt"
+	"github.com/wlchs/advent_of_code_go_template/internal/registry"
 	"github.com/wlchs/advent_of_code_go_template/utils"
 	"regexp"
 	"strconv"
 	"strings"
 )
 
+// Solver of the daily challenge, registered in the solver registry
+var Solver = registry.Register(registry.NewSolver(2023, 15, "Lens Library", Part1, Part2))
+
 // lens consists of a string label and a focal length
 type lens struct {
 	label string
 	focal int
 }
 
-// Run function of the daily challenge
-func Run(input []string, mode int) {
-	if mode == 1 || mode == 3 {
-		fmt.Printf("Part one: %v\n", Part1(input))
-	}
-	if mode == 2 || mode == 3 {
-		fmt.Printf("Part two: %v\n", Part2(input))
-	}
-}
-
 // Part1 solves the first part of the exercise
 func Part1(input []string) string {
 	sum := 0
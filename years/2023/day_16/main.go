@@ -1,13 +1,15 @@
 package day_16
 
 import (
-	"fmt"
+	"github.com/wlchs/advent_of_code_go_template/internal/registry"
 	"github.com/wlchs/advent_of_code_go_template/types"
-	"github.com/wlchs/advent_of_code_go_template/utils"
 	"slices"
 	"strconv"
 )
 
+// Solver of the daily challenge, registered in the solver registry
+var Solver = registry.Register(registry.NewSolver(2023, 16, "The Floor Will Be Lava", Part1, Part2))
+
 // Beam represents a location and a direction vector of a beam.
 type Beam struct {
 	types.Vec2
@@ -15,10 +17,10 @@ type Beam struct {
 }
 
 // next calculates the next position(s) of the beam based on splitters and mirrors of the input
-func (b Beam) next(m map[types.Vec2]int32) []Beam {
+func (b Beam) next(m *types.Grid[int32]) []Beam {
 	nextLocation := b.Vec2.Add(&b.dir)
 	var beams []Beam
-	switch m[nextLocation] {
+	switch m.At(nextLocation) {
 	case '.':
 		beams = append(beams, Beam{Vec2: nextLocation, dir: b.dir})
 	case '/':
@@ -51,19 +53,9 @@ func (b Beam) next(m map[types.Vec2]int32) []Beam {
 	return beams
 }
 
-// Run function of the daily challenge
-func Run(input []string, mode int) {
-	if mode == 1 || mode == 3 {
-		fmt.Printf("Part one: %v\n", Part1(input))
-	}
-	if mode == 2 || mode == 3 {
-		fmt.Printf("Part two: %v\n", Part2(input))
-	}
-}
-
 // Part1 solves the first part of the exercise
 func Part1(input []string) string {
-	m := utils.ParseInputToMap(input)
+	m := types.ParseGrid(input)
 	v := map[types.Vec2]bool{}
 	moveBeams(m, []Beam{
 		{
@@ -76,12 +68,12 @@ func Part1(input []string) string {
 
 // Part2 solves the second part of the exercise
 func Part2(input []string) string {
-	m := utils.ParseInputToMap(input)
-	br := bottomRight(m)
+	m := types.ParseGrid(input)
+	br := types.Vec2{X: m.Width() - 1, Y: m.Height() - 1}
 	maxEnergy := 0
-	for vec := range m {
+	m.Each(func(vec types.Vec2, _ int32) {
 		if vec.X > 0 && vec.X < br.X && vec.Y > 0 && vec.Y < br.Y {
-			continue
+			return
 		}
 		v := map[types.Vec2]bool{}
 		start := types.Vec2{X: 0, Y: 0}
@@ -106,12 +98,12 @@ func Part2(input []string) string {
 			},
 		}, v)
 		maxEnergy = max(maxEnergy, energy(v))
-	}
+	})
 	return strconv.Itoa(maxEnergy)
 }
 
 // moveBeams iterates over the map and marks visited fields by beams.
-func moveBeams(m map[types.Vec2]int32, beams []Beam, v map[types.Vec2]bool) {
+func moveBeams(m *types.Grid[int32], beams []Beam, v map[types.Vec2]bool) {
 	var beamMemory []Beam
 	for len(beams) > 0 {
 		beamMemory = append(beamMemory, beams...)
@@ -140,13 +132,3 @@ func energy(v map[types.Vec2]bool) int {
 	}
 	return sum
 }
-
-// bottomRight finds the element at the bottom right position of the map
-func bottomRight(m map[types.Vec2]int32) types.Vec2 {
-	r := types.Vec2{X: 0, Y: 0}
-	for vec := range m {
-		r.X = max(r.X, vec.X)
-		r.Y = max(r.Y, vec.Y)
-	}
-	return r
-}
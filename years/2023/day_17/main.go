@@ -0,0 +1,86 @@
+package day_17
+
+import (
+	"context"
+	"fmt"
+	"github.com/wlchs/advent_of_code_go_template/graph"
+	"github.com/wlchs/advent_of_code_go_template/internal/registry"
+	"github.com/wlchs/advent_of_code_go_template/types"
+	"strconv"
+)
+
+// Solver of the daily challenge, registered in the solver registry
+var Solver = registry.Register(registry.NewContextSolver(2023, 17, "Clumsy Crucible", Part1, Part2))
+
+// Crucible holds a position of the crucible, its direction and the number of blocks it has moved straight.
+type Crucible struct {
+	pos types.Vec2
+	dir types.Vec2
+	run int
+}
+
+// Part1 solves the first part of the exercise
+// the crucible can move at most 3 blocks straight
+func Part1(ctx context.Context, input []string) (string, error) {
+	return minimalHeatLoss(ctx, input, 1, 3)
+}
+
+// Part2 solves the second part of the exercise
+// the ultra crucible must move at least 4 blocks straight but not more than 10
+// The limits can be overridden with the "min" and "max" parameters.
+func Part2(ctx context.Context, input []string) (string, error) {
+	minRun, err := registry.IntParam(ctx, "min", 4)
+	if err != nil {
+		return "", err
+	}
+	maxRun, err := registry.IntParam(ctx, "max", 10)
+	if err != nil {
+		return "", err
+	}
+	return minimalHeatLoss(ctx, input, minRun, maxRun)
+}
+
+// minimalHeatLoss finds the path from top left to bottom right with the minimal possible heat loss, where the crucible
+// moves between minRun and maxRun blocks straight before turning or stopping
+// it stops early if the context is done
+func minimalHeatLoss(ctx context.Context, input []string, minRun int, maxRun int) (string, error) {
+	if minRun < 1 || maxRun < minRun {
+		return "", fmt.Errorf("invalid straight run limits %d-%d", minRun, maxRun)
+	}
+	m := types.ParseGrid(input)
+	br := types.Vec2{X: m.Width() - 1, Y: m.Height() - 1}
+	starts := []Crucible{{dir: types.E}, {dir: types.S}}
+	goal := func(c Crucible) bool {
+		return c.pos == br && c.run >= minRun
+	}
+	p, err := graph.Dijkstra(ctx, starts, func(c Crucible) []graph.Edge[Crucible] {
+		return neighbours(m, c, minRun, maxRun)
+	}, goal)
+	if err != nil {
+		return "", err
+	}
+	return strconv.Itoa(p.Cost), nil
+}
+
+// neighbours gives back the states the crucible can move to with the heat loss of the entered block
+// it keeps going straight until maxRun blocks and can only turn after minRun blocks
+func neighbours(m *types.Grid[int32], c Crucible, minRun int, maxRun int) []graph.Edge[Crucible] {
+	var res []graph.Edge[Crucible]
+	next := func(dir types.Vec2, run int) {
+		pos := c.pos.Add(&dir)
+		if m.In(pos) {
+			res = append(res, graph.Edge[Crucible]{
+				To:   Crucible{pos: pos, dir: dir, run: run},
+				Cost: int(m.At(pos) - '0'),
+			})
+		}
+	}
+	if c.run < maxRun {
+		next(c.dir, c.run+1)
+	}
+	if c.run >= minRun {
+		next(c.dir.RotateLeft(), 1)
+		next(c.dir.RotateRight(), 1)
+	}
+	return res
+}
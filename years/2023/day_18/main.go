@@ -1,7 +1,7 @@
 package day_18
 
 import (
-	"fmt"
+	"github.com/wlchs/advent_of_code_go_template/internal/registry"
 	"github.com/wlchs/advent_of_code_go_template/types"
 	"github.com/wlchs/advent_of_code_go_template/utils"
 	"regexp"
@@ -9,22 +9,15 @@ import (
 	"strings"
 )
 
+// Solver of the daily challenge, registered in the solver registry
+var Solver = registry.Register(registry.NewSolver(2023, 18, "Lavaduct Lagoon", Part1, Part2))
+
 // DigInstruction contains a single row of input representing a digging vector
 type DigInstruction struct {
 	vec    types.Vec2
 	length int
 }
 
-// Run function of the daily challenge
-func Run(input []string, mode int) {
-	if mode == 1 || mode == 3 {
-		fmt.Printf("Part one: %v\n", Part1(input))
-	}
-	if mode == 2 || mode == 3 {
-		fmt.Printf("Part two: %v\n", Part2(input))
-	}
-}
-
 // Part1 solves the first part of the exercise
 func Part1(input []string) string {
 	i := readInput(input)
@@ -43,16 +36,11 @@ func readInput(input []string) []DigInstruction {
 	for _, s := range input {
 		i := strings.Split(s, " ")
 		var di DigInstruction
-		switch i[0] {
-		case "U":
-			di.vec = types.Vec2{Y: -1}
-		case "D":
-			di.vec = types.Vec2{Y: 1}
-		case "L":
-			di.vec = types.Vec2{X: -1}
-		case "R":
-			di.vec = types.Vec2{X: 1}
+		vec, err := types.ParseDirection(rune(i[0][0]))
+		if err != nil {
+			panic(err)
 		}
+		di.vec = vec
 		di.length = utils.Atoi(i[1])
 		res = append(res, di)
 	}
@@ -68,13 +56,13 @@ func readHexInput(input []string) []DigInstruction {
 		var di DigInstruction
 		switch match[5] {
 		case '0':
-			di.vec = types.Vec2{X: 1}
+			di.vec = types.E
 		case '1':
-			di.vec = types.Vec2{Y: 1}
+			di.vec = types.S
 		case '2':
-			di.vec = types.Vec2{X: -1}
+			di.vec = types.W
 		case '3':
-			di.vec = types.Vec2{Y: -1}
+			di.vec = types.N
 		}
 		decimal, _ := strconv.ParseInt(match[:5], 16, 32)
 		di.length = int(decimal)
@@ -90,7 +78,7 @@ func dig(instructions []DigInstruction) int {
 	walls := 0
 	for _, instruction := range instructions {
 		vertices = append(vertices, cur)
-		delta := instruction.vec.Multiply(instruction.length)
+		delta := instruction.vec.Scale(instruction.length)
 		walls += instruction.length
 		cur = cur.Add(&delta)
 	}
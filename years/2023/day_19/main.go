@@ -1,7 +1,8 @@
 package day_19
 
 import (
-	"fmt"
+	"github.com/wlchs/advent_of_code_go_template/internal/registry"
+	"github.com/wlchs/advent_of_code_go_template/types"
 	"github.com/wlchs/advent_of_code_go_template/utils"
 	"maps"
 	"regexp"
@@ -9,15 +10,8 @@ import (
 	"strings"
 )
 
-// Run function of the daily challenge
-func Run(input []string, mode int) {
-	if mode == 1 || mode == 3 {
-		fmt.Printf("Part one: %v\n", Part1(input))
-	}
-	if mode == 2 || mode == 3 {
-		fmt.Printf("Part two: %v\n", Part2(input))
-	}
-}
+// Solver of the daily challenge, registered in the solver registry
+var Solver = registry.Register(registry.NewSolver(2023, 19, "Aplenty", Part1, Part2))
 
 // Part1 solves the first part of the exercise
 func Part1(input []string) string {
@@ -34,11 +28,12 @@ func Part1(input []string) string {
 // Part2 solves the second part of the exercise
 func Part2(input []string) string {
 	workflows, _ := parseInput(input)
-	sum := execCount("in", map[string][]int{
-		"x": {1, 4000},
-		"m": {1, 4000},
-		"a": {1, 4000},
-		"s": {1, 4000},
+	full := types.NewIntervalSet(types.Closed(1, 4000))
+	sum := execCount("in", map[string]types.IntervalSet{
+		"x": full,
+		"m": full,
+		"a": full,
+		"s": full,
 	}, workflows)
 	return strconv.Itoa(sum)
 }
@@ -98,7 +93,7 @@ func exec(fn string, ratings map[string]int, workflows map[string][]string) bool
 }
 
 // execCount counts how many different rating combinations will yield A starting from the current workflow
-func execCount(fn string, ratings map[string][]int, workflows map[string][]string) int {
+func execCount(fn string, ratings map[string]types.IntervalSet, workflows map[string][]string) int {
 	if fn == "A" {
 		return multiplyAll(ratings)
 	} else if fn == "R" {
@@ -111,15 +106,17 @@ func execCount(fn string, ratings map[string][]int, workflows map[string][]strin
 		matchesCondition := reCondition.FindStringSubmatch(step)
 		if len(matchesCondition) > 0 {
 			clone := maps.Clone(ratings)
+			category := matchesCondition[1]
 			if matchesCondition[2] == "<" {
-				clone[matchesCondition[1]] = []int{clone[matchesCondition[1]][0], min(clone[matchesCondition[1]][1], utils.Atoi(matchesCondition[3])-1)}
-				counts += execCount(matchesCondition[4], clone, workflows)
-				ratings[matchesCondition[1]] = []int{max(ratings[matchesCondition[1]][0], utils.Atoi(matchesCondition[3])), ratings[matchesCondition[1]][1]}
+				below, above := ratings[category].SplitAt(utils.Atoi(matchesCondition[3]))
+				clone[category] = below
+				ratings[category] = above
 			} else {
-				clone[matchesCondition[1]] = []int{max(clone[matchesCondition[1]][0], utils.Atoi(matchesCondition[3])+1), clone[matchesCondition[1]][1]}
-				counts += execCount(matchesCondition[4], clone, workflows)
-				ratings[matchesCondition[1]] = []int{ratings[matchesCondition[1]][0], min(ratings[matchesCondition[1]][1], utils.Atoi(matchesCondition[3]))}
+				below, above := ratings[category].SplitAt(utils.Atoi(matchesCondition[3]) + 1)
+				clone[category] = above
+				ratings[category] = below
 			}
+			counts += execCount(matchesCondition[4], clone, workflows)
 		} else {
 			counts += execCount(step, ratings, workflows)
 		}
@@ -137,10 +134,10 @@ func addAll(rating map[string]int) int {
 }
 
 // multiplyAll multiplies all possible rating combinations
-func multiplyAll(ratings map[string][]int) int {
+func multiplyAll(ratings map[string]types.IntervalSet) int {
 	product := 1
-	for _, ints := range ratings {
-		product *= ints[1] - ints[0] + 1
+	for _, set := range ratings {
+		product *= set.Len()
 	}
 	return product
 }
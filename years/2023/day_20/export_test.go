@@ -0,0 +1,12 @@
+package day_20
+
+import "strconv"
+
+// FinalState records the given iterations of as many modules in a message queue and gets its final RX state
+func FinalState(iterations ...[]int) (int, error) {
+	mq := messageQueue{lastCount: len(iterations), lastStateMap: map[Module][]int{}}
+	for i, iters := range iterations {
+		mq.lastStateMap[&Dummy{name: strconv.Itoa(i)}] = iters
+	}
+	return mq.finalState()
+}
@@ -1,12 +1,18 @@
 package day_20
 
 import (
-	"fmt"
+	"context"
+	"github.com/wlchs/advent_of_code_go_template/internal/registry"
+	"github.com/wlchs/advent_of_code_go_template/mathx"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 )
 
+// Solver of the daily challenge, registered in the solver registry
+var Solver = registry.Register(registry.NewContextSolver(2023, 20, "Pulse Propagation", registry.IgnoreContext(Part1), Part2))
+
 // MessageQueueEntry holds a tuple of Module pointer and input pulse
 type MessageQueueEntry struct {
 	source Module
@@ -27,7 +33,7 @@ type messageQueue struct {
 	lowCount     int
 	highCount    int
 	lastCount    int
-	lastStateMap map[Module]int
+	lastStateMap map[Module][]int
 }
 
 // add method adds a new MessageQueueEntry to the MessageQueue
@@ -48,26 +54,34 @@ func (receiver *messageQueue) pop() MessageQueueEntry {
 }
 
 // addFinalState adds a terminating criteria module to the message queue
+// the first two iterations are kept, which give the offset and the length of the module's cycle
 func (receiver *messageQueue) addFinalState(m Module, states int, iter int) {
 	receiver.lastCount = states
-	_, ok := receiver.lastStateMap[m]
-	if !ok {
-		receiver.lastStateMap[m] = iter
+	iters := receiver.lastStateMap[m]
+	if len(iters) < 2 && !slices.Contains(iters, iter) {
+		receiver.lastStateMap[m] = append(iters, iter)
 	}
 }
 
-// finalState gets the final RX state iteration count
-func (receiver *messageQueue) finalState() int {
-	c := 0
-	product := 1
-	for _, iter := range receiver.lastStateMap {
-		c++
-		product = lcm(product, iter)
+// finalState gets the final RX state iteration count, or 0 if the cycles of the modules aren't known yet
+func (receiver *messageQueue) finalState() (int, error) {
+	if len(receiver.lastStateMap) == 0 || len(receiver.lastStateMap) != receiver.lastCount {
+		return 0, nil
+	}
+	congruences := make([]mathx.Congruence, 0, len(receiver.lastStateMap))
+	start := 0
+	for _, iters := range receiver.lastStateMap {
+		if len(iters) < 2 {
+			return 0, nil
+		}
+		congruences = append(congruences, mathx.Congruence{Residue: iters[0], Modulus: iters[1] - iters[0]})
+		start = max(start, iters[0])
 	}
-	if c > 0 && c == receiver.lastCount {
-		return product
+	c, err := mathx.CRT(congruences...)
+	if err != nil {
+		return 0, err
 	}
-	return 0
+	return c.First(start), nil
 }
 
 // Module is the general module interface implemented by all module types
@@ -208,16 +222,6 @@ func (receiver *Dummy) isRX() bool {
 	return receiver.name == "rx"
 }
 
-// Run function of the daily challenge
-func Run(input []string, mode int) {
-	if mode == 1 || mode == 3 {
-		fmt.Printf("Part one: %v\n", Part1(input))
-	}
-	if mode == 2 || mode == 3 {
-		fmt.Printf("Part two: %v\n", Part2(input))
-	}
-}
-
 // Part1 solves the first part of the exercise
 func Part1(input []string) string {
 	mq, broadcaster := parseInput(input)
@@ -235,12 +239,18 @@ func Part1(input []string) string {
 }
 
 // Part2 solves the second part of the exercise
-func Part2(input []string) string {
+func Part2(ctx context.Context, input []string) (string, error) {
 	mq, broadcaster := parseInput(input)
 	for i := 1; ; i++ {
-		fs := mq.finalState()
+		if err := ctx.Err(); err != nil {
+			return "", err
+		}
+		fs, err := mq.finalState()
+		if err != nil {
+			return "", err
+		}
 		if fs > 0 {
-			return strconv.Itoa(fs)
+			return strconv.Itoa(fs), nil
 		}
 		mq.add(MessageQueueEntry{
 			target: broadcaster,
@@ -249,7 +259,7 @@ func Part2(input []string) string {
 		for len(mq.entries) > 0 {
 			e := mq.pop()
 			if e.target.isRX() && !e.pulse {
-				return strconv.Itoa(i)
+				return strconv.Itoa(i), nil
 			}
 			e.target.pulse(e.pulse, e.source, i)
 		}
@@ -263,7 +273,7 @@ func parseInput(input []string) (*messageQueue, *Broadcaster) {
 		lowCount:     0,
 		highCount:    0,
 		lastCount:    0,
-		lastStateMap: map[Module]int{},
+		lastStateMap: map[Module][]int{},
 	}
 	moduleMap := map[string]Module{}
 	flipFlops := map[string]FlipFlop{}
@@ -311,16 +321,3 @@ func parseInput(input []string) (*messageQueue, *Broadcaster) {
 	}
 	return &mq, &broadcaster
 }
-
-// gcd calculates the greatest common divisor of a and b.
-func gcd(a int, b int) int {
-	if b == 0 {
-		return a
-	}
-	return gcd(b, a%b)
-}
-
-// lcm calculates the least common multiple of a and b.
-func lcm(a int, b int) int {
-	return (a / gcd(a, b)) * b
-}
@@ -0,0 +1,47 @@
+package day_20_test
+
+import (
+	"errors"
+	"github.com/wlchs/advent_of_code_go_template/internal/harness"
+	"github.com/wlchs/advent_of_code_go_template/mathx"
+	"github.com/wlchs/advent_of_code_go_template/years/2023/day_20"
+	"testing"
+)
+
+func TestExamples(t *testing.T) {
+	t.Parallel()
+
+	harness.Test(t, day_20.Solver, ".")
+}
+
+func TestFinalState(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		iterations [][]int
+		expected   int
+		err        error
+	}{
+		{name: "unknown cycle", iterations: [][]int{{3, 6}, {4}}, expected: 0},
+		{name: "no offset", iterations: [][]int{{3, 6}, {4, 8}}, expected: 12},
+		{name: "offset", iterations: [][]int{{2, 5}, {3, 7}}, expected: 11},
+		{name: "offset beyond the cycle length", iterations: [][]int{{7, 10}, {5, 10}}, expected: 10},
+		{name: "common cycle factor", iterations: [][]int{{2, 6}, {4, 10}}, expected: 10},
+		{name: "no solution", iterations: [][]int{{1, 3}, {2, 6}}, err: mathx.ErrNoSolution},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := day_20.FinalState(tt.iterations...)
+			if !errors.Is(err, tt.err) {
+				t.Errorf("expected error %v, but got %v instead", tt.err, err)
+			}
+			if actual != tt.expected {
+				t.Errorf("expected %d, but got %d instead", tt.expected, actual)
+			}
+		})
+	}
+}
@@ -1,51 +1,62 @@
 package day_21
 
 import (
-	"fmt"
+	"context"
+	"github.com/wlchs/advent_of_code_go_template/internal/registry"
 	"github.com/wlchs/advent_of_code_go_template/types"
-	"github.com/wlchs/advent_of_code_go_template/utils"
 	"slices"
 	"strconv"
 )
 
-// Run function of the daily challenge
-func Run(input []string, mode int) {
-	if mode == 1 || mode == 3 {
-		fmt.Printf("Part one: %v\n", Part1(input))
-	}
-	if mode == 2 || mode == 3 {
-		fmt.Printf("Part two: %v\n", Part2(input))
-	}
-}
+// Solver of the daily challenge, registered in the solver registry
+var Solver = registry.Register(registry.NewContextSolver(2023, 21, "Step Counter", Part1, Part2))
 
 // Part1 solves the first part of the exercise
-func Part1(input []string) string {
-	m := utils.ParseInputToMap(input)
+// The number of steps can be overridden with the "steps" parameter.
+func Part1(ctx context.Context, input []string) (string, error) {
+	steps, err := registry.IntParam(ctx, "steps", 64)
+	if err != nil {
+		return "", err
+	}
+	m := types.ParseGrid(input)
 	s := findStart(m)
-	return strconv.Itoa(countFields(m, s))
+	c, err := countFields(ctx, m, s, steps)
+	if err != nil {
+		return "", err
+	}
+	return strconv.Itoa(c), nil
 }
 
 // Part2 solves the second part of the exercise
-func Part2(input []string) string {
-	m := utils.ParseInputToMap(input)
+func Part2(ctx context.Context, input []string) (string, error) {
+	m := types.ParseGrid(input)
 	s := findStart(m)
-	return strconv.Itoa(countInfiniteFields(m, s))
+	c, err := countInfiniteFields(ctx, m, s)
+	if err != nil {
+		return "", err
+	}
+	return strconv.Itoa(c), nil
 }
 
 // findStart finds the 'S' node's coordinates on the input map
-func findStart(m map[types.Vec2]int32) types.Vec2 {
-	for coords, field := range m {
-		if field == 'S' {
-			return coords
-		}
+func findStart(m *types.Grid[int32]) types.Vec2 {
+	s, ok := m.Find(func(field int32) bool {
+		return field == 'S'
+	})
+	if !ok {
+		panic("node not found")
 	}
-	panic("node not found")
+	return s
 }
 
-// countFields counts the number of reachable fields in 64 steps starting from the given coordinates
-func countFields(m map[types.Vec2]int32, s types.Vec2) int {
+// countFields counts the number of reachable fields in the given number of steps starting from the given coordinates
+// it stops early if the context is done
+func countFields(ctx context.Context, m *types.Grid[int32], s types.Vec2, steps int) (int, error) {
 	acc := []types.Vec2{s}
-	for n := 0; n < 64; n++ {
+	for n := 0; n < steps; n++ {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
 		var nextAcc []types.Vec2
 		for _, vec2 := range acc {
 			ns := neighbours(m, vec2)
@@ -53,19 +64,22 @@ func countFields(m map[types.Vec2]int32, s types.Vec2) int {
 		}
 		acc = nextAcc
 	}
-	return len(acc)
+	return len(acc), nil
 }
 
 // countInfiniteFields counts the number of reachable fields in 26501365 steps starting from the given coordinates.
-// The map wraps around infinitely
-func countInfiniteFields(m map[types.Vec2]int32, s types.Vec2) int {
-	d := bottomRight(m)
+// The map wraps around infinitely. It stops early if the context is done.
+func countInfiniteFields(ctx context.Context, m *types.Grid[int32], s types.Vec2) (int, error) {
+	d := types.Vec2{X: m.Width(), Y: m.Height()}
 	init := make([]int, d.X)
 	delta := make([]int, d.X)
 	prevs := make([]int, d.X)
 	var accPrev []types.Vec2
 	accNext := []types.Vec2{s}
 	for n := 1; n < 3*d.X; n++ {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
 		var accDelta []types.Vec2
 		for _, vec2 := range accNext {
 			ns := infiniteNeighbours(m, vec2, d)
@@ -90,7 +104,7 @@ func countInfiniteFields(m map[types.Vec2]int32, s types.Vec2) int {
 	for i := mx % 2; i <= mx; i += 2 {
 		c += step(init, prevs, delta, i, d.X)
 	}
-	return c
+	return c, nil
 }
 
 // step counts the number of newly visited fields as the given iteration
@@ -102,11 +116,11 @@ func step(init []int, prevs []int, delta []int, x int, d int) int {
 }
 
 // neighbours calculates the neighbouring vectors of the given one and checks whether the input map has a navigable field on them
-func neighbours(m map[types.Vec2]int32, vec2 types.Vec2) []types.Vec2 {
+func neighbours(m *types.Grid[int32], vec2 types.Vec2) []types.Vec2 {
 	res := make([]types.Vec2, 0, 4)
 	n := vec2.Around()
 	for _, v := range n {
-		if m[v] == '.' || m[v] == 'S' {
+		if m.At(v) == '.' || m.At(v) == 'S' {
 			res = append(res, v)
 		}
 	}
@@ -115,12 +129,12 @@ func neighbours(m map[types.Vec2]int32, vec2 types.Vec2) []types.Vec2 {
 
 // infiniteNeighbours calculates the neighbouring vectors of the given one and checks whether the input map has a navigable field on them.
 // The input map wraps around infinitely.
-func infiniteNeighbours(m map[types.Vec2]int32, vec2 types.Vec2, d types.Vec2) []types.Vec2 {
+func infiniteNeighbours(m *types.Grid[int32], vec2 types.Vec2, d types.Vec2) []types.Vec2 {
 	res := make([]types.Vec2, 0, 4)
 	n := vec2.Around()
 	for _, v := range n {
 		vProj := types.Vec2{X: mod(v.X, d.X), Y: mod(v.Y, d.Y)}
-		if m[vProj] == '.' || m[vProj] == 'S' {
+		if m.At(vProj) == '.' || m.At(vProj) == 'S' {
 			res = append(res, v)
 		}
 	}
@@ -137,16 +151,6 @@ func addIfNotAlready(acc []types.Vec2, neighbours []types.Vec2) []types.Vec2 {
 	return acc
 }
 
-// bottomRight finds the element at the bottom right position of the map
-func bottomRight(m map[types.Vec2]int32) types.Vec2 {
-	r := types.Vec2{X: 0, Y: 0}
-	for vec := range m {
-		r.X = max(r.X, vec.X+1)
-		r.Y = max(r.Y, vec.Y+1)
-	}
-	return r
-}
-
 // mod implements a modulo function that returns an always positive remainder
 func mod(i int, m int) int {
 	return ((i % m) + m) % m
@@ -1,7 +1,7 @@
 package day_22
 
 import (
-	"fmt"
+	"github.com/wlchs/advent_of_code_go_template/internal/registry"
 	"github.com/wlchs/advent_of_code_go_template/types"
 	"github.com/wlchs/advent_of_code_go_template/utils"
 	"math"
@@ -10,6 +10,9 @@ import (
 	"strings"
 )
 
+// Solver of the daily challenge, registered in the solver registry
+var Solver = registry.Register(registry.NewSolver(2023, 22, "Sand Slabs", Part1, Part2))
+
 // Brick is a 3D object defined by its 2 corners across one of its diagonals
 type Brick struct {
 	cornerA     types.Vec3
@@ -65,16 +68,6 @@ func (b Brick) pushDown(bricks []Brick) Brick {
 	}
 }
 
-// Run function of the daily challenge
-func Run(input []string, mode int) {
-	if mode == 1 || mode == 3 {
-		fmt.Printf("Part one: %v\n", Part1(input))
-	}
-	if mode == 2 || mode == 3 {
-		fmt.Printf("Part two: %v\n", Part2(input))
-	}
-}
-
 // Part1 solves the first part of the exercise
 func Part1(input []string) string {
 	bricks := prepareBricks(input)
@@ -1,13 +1,16 @@
 package day_23
 
 import (
-	"fmt"
+	"context"
+	"github.com/wlchs/advent_of_code_go_template/internal/registry"
 	"github.com/wlchs/advent_of_code_go_template/types"
-	"github.com/wlchs/advent_of_code_go_template/utils"
 	"slices"
 	"strconv"
 )
 
+// Solver of the daily challenge, registered in the solver registry
+var Solver = registry.Register(registry.NewContextSolver(2023, 23, "A Long Walk", Part1, Part2))
+
 // Node represents a node of the hiking graph
 type Node struct {
 	pos   types.Vec2
@@ -20,37 +23,35 @@ type Edge struct {
 	distance int
 }
 
-// Run function of the daily challenge
-func Run(input []string, mode int) {
-	if mode == 1 || mode == 3 {
-		fmt.Printf("Part one: %v\n", Part1(input))
-	}
-	if mode == 2 || mode == 3 {
-		fmt.Printf("Part two: %v\n", Part2(input))
-	}
-}
-
 // Part1 solves the first part of the exercise
-func Part1(input []string) string {
-	m := utils.ParseInputToMap(input)
+func Part1(ctx context.Context, input []string) (string, error) {
+	m := types.ParseGrid(input)
 	start, end := buildGraph(m, false)
-	return strconv.Itoa(findLongestPath([]Edge{start.edges[0]}, end))
+	longest, err := findLongestPath(ctx, []Edge{start.edges[0]}, end)
+	if err != nil {
+		return "", err
+	}
+	return strconv.Itoa(longest), nil
 }
 
 // Part2 solves the second part of the exercise
-func Part2(input []string) string {
-	m := utils.ParseInputToMap(input)
+func Part2(ctx context.Context, input []string) (string, error) {
+	m := types.ParseGrid(input)
 	start, end := buildGraph(m, true)
-	return strconv.Itoa(findLongestPath([]Edge{start.edges[0]}, end))
+	longest, err := findLongestPath(ctx, []Edge{start.edges[0]}, end)
+	if err != nil {
+		return "", err
+	}
+	return strconv.Itoa(longest), nil
 }
 
 // buildGraph iterates over the map and builds a graph where the edge weights correspond to the distances between neighbouring junctions
-func buildGraph(m map[types.Vec2]int32, ignoreSlopes bool) (*Node, *Node) {
+func buildGraph(m *types.Grid[int32], ignoreSlopes bool) (*Node, *Node) {
 	root := &Node{pos: findStart(m)}
 	nodeMap := map[types.Vec2]*Node{}
 	nodeMap[root.pos] = root
 	nodes := []*Node{root}
-	dim := bottomRight(m)
+	dim := types.Vec2{X: m.Width(), Y: m.Height()}
 
 	for len(nodes) > 0 {
 		node := nodes[0]
@@ -73,38 +74,27 @@ func buildGraph(m map[types.Vec2]int32, ignoreSlopes bool) (*Node, *Node) {
 }
 
 // findStart finds the starting node of the graph
-func findStart(m map[types.Vec2]int32) types.Vec2 {
+func findStart(m *types.Grid[int32]) types.Vec2 {
 	for x := 0; ; x++ {
 		vec := types.Vec2{X: x}
-		if m[vec] == '.' {
+		if m.At(vec) == '.' {
 			return vec
 		}
 	}
 }
 
 // findEnd finds the final node of the graph
-func findEnd(m map[types.Vec2]int32) types.Vec2 {
-	dim := bottomRight(m)
+func findEnd(m *types.Grid[int32]) types.Vec2 {
 	for x := 0; ; x++ {
-		vec := types.Vec2{X: x, Y: dim.Y - 1}
-		if m[vec] == '.' {
+		vec := types.Vec2{X: x, Y: m.Height() - 1}
+		if m.At(vec) == '.' {
 			return vec
 		}
 	}
 }
 
-// bottomRight finds the element at the bottom right position of the map
-func bottomRight(m map[types.Vec2]int32) types.Vec2 {
-	r := types.Vec2{X: 0, Y: 0}
-	for vec := range m {
-		r.X = max(r.X, vec.X+1)
-		r.Y = max(r.Y, vec.Y+1)
-	}
-	return r
-}
-
 // findEdges finds the neighbouring nodes and their distances
-func findEdges(m map[types.Vec2]int32, node *Node, nodeMap map[types.Vec2]*Node, ignoreSlopes bool, dim types.Vec2) []Edge {
+func findEdges(m *types.Grid[int32], node *Node, nodeMap map[types.Vec2]*Node, ignoreSlopes bool, dim types.Vec2) []Edge {
 	e := make([]Edge, 0, 4)
 	initialOptions := findNextOptions(m, []types.Vec2{node.pos}, dim, ignoreSlopes)
 
@@ -131,7 +121,7 @@ func findEdges(m map[types.Vec2]int32, node *Node, nodeMap map[types.Vec2]*Node,
 }
 
 // findNextOptions finds the next possible junction on the hiking path
-func findNextOptions(m map[types.Vec2]int32, path []types.Vec2, dim types.Vec2, ignoreSlopes bool) []types.Vec2 {
+func findNextOptions(m *types.Grid[int32], path []types.Vec2, dim types.Vec2, ignoreSlopes bool) []types.Vec2 {
 	if ignoreSlopes {
 		return findNonSlipperyNextOptions(m, path, dim)
 	} else {
@@ -140,23 +130,23 @@ func findNextOptions(m map[types.Vec2]int32, path []types.Vec2, dim types.Vec2,
 }
 
 // findSlipperyNextOptions checks the surrounding fields for the next step of the hike
-func findSlipperyNextOptions(m map[types.Vec2]int32, path []types.Vec2, dim types.Vec2) []types.Vec2 {
+func findSlipperyNextOptions(m *types.Grid[int32], path []types.Vec2, dim types.Vec2) []types.Vec2 {
 	options := make([]types.Vec2, 0, 4)
 	pos := path[len(path)-1]
 	up := pos.Up()
-	if up.Y >= 0 && m[up] != 'v' && m[up] != '#' && !slices.Contains(path, up) {
+	if up.Y >= 0 && m.At(up) != 'v' && m.At(up) != '#' && !slices.Contains(path, up) {
 		options = append(options, up)
 	}
 	down := pos.Down()
-	if down.Y < dim.Y && m[down] != '^' && m[down] != '#' && !slices.Contains(path, down) {
+	if down.Y < dim.Y && m.At(down) != '^' && m.At(down) != '#' && !slices.Contains(path, down) {
 		options = append(options, down)
 	}
 	left := pos.Left()
-	if left.X >= 0 && m[left] != '>' && m[left] != '#' && !slices.Contains(path, left) {
+	if left.X >= 0 && m.At(left) != '>' && m.At(left) != '#' && !slices.Contains(path, left) {
 		options = append(options, left)
 	}
 	right := pos.Right()
-	if right.X < dim.X && m[right] != '<' && m[right] != '#' && !slices.Contains(path, right) {
+	if right.X < dim.X && m.At(right) != '<' && m.At(right) != '#' && !slices.Contains(path, right) {
 		options = append(options, right)
 	}
 	return options
@@ -164,30 +154,34 @@ func findSlipperyNextOptions(m map[types.Vec2]int32, path []types.Vec2, dim type
 
 // findNonSlipperyNextOptions checks the surrounding fields for the next step of the hike
 // this variant of the method ignores steep slopes
-func findNonSlipperyNextOptions(m map[types.Vec2]int32, path []types.Vec2, dim types.Vec2) []types.Vec2 {
+func findNonSlipperyNextOptions(m *types.Grid[int32], path []types.Vec2, dim types.Vec2) []types.Vec2 {
 	options := make([]types.Vec2, 0, 4)
 	pos := path[len(path)-1]
 	up := pos.Up()
-	if up.Y >= 0 && m[up] != '#' && !slices.Contains(path, up) {
+	if up.Y >= 0 && m.At(up) != '#' && !slices.Contains(path, up) {
 		options = append(options, up)
 	}
 	down := pos.Down()
-	if down.Y < dim.Y && m[down] != '#' && !slices.Contains(path, down) {
+	if down.Y < dim.Y && m.At(down) != '#' && !slices.Contains(path, down) {
 		options = append(options, down)
 	}
 	left := pos.Left()
-	if left.X >= 0 && m[left] != '#' && !slices.Contains(path, left) {
+	if left.X >= 0 && m.At(left) != '#' && !slices.Contains(path, left) {
 		options = append(options, left)
 	}
 	right := pos.Right()
-	if right.X < dim.X && m[right] != '#' && !slices.Contains(path, right) {
+	if right.X < dim.X && m.At(right) != '#' && !slices.Contains(path, right) {
 		options = append(options, right)
 	}
 	return options
 }
 
 // findLongestPath iterates over the input map and finds the longest hiking path without loops
-func findLongestPath(path []Edge, end *Node) int {
+// it stops early if the context is done
+func findLongestPath(ctx context.Context, path []Edge, end *Node) (int, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
 	pathLength := len(path)
 	node := path[pathLength-1].target
 
@@ -196,7 +190,7 @@ func findLongestPath(path []Edge, end *Node) int {
 		for _, edge := range path {
 			sum += edge.distance
 		}
-		return sum
+		return sum, nil
 	}
 
 	longest := 0
@@ -208,8 +202,12 @@ func findLongestPath(path []Edge, end *Node) int {
 		if !containsNode {
 			newPath := slices.Clone(path)
 			newPath = append(newPath, edge)
-			longest = max(longest, findLongestPath(newPath, end))
+			l, err := findLongestPath(ctx, newPath, end)
+			if err != nil {
+				return 0, err
+			}
+			longest = max(longest, l)
 		}
 	}
-	return longest
+	return longest, nil
 }
@@ -1,13 +1,16 @@
 package day_24
 
 import (
-	"fmt"
+	"github.com/wlchs/advent_of_code_go_template/internal/registry"
 	"github.com/wlchs/advent_of_code_go_template/utils"
 	"math/big"
 	"regexp"
 	"strconv"
 )
 
+// Solver of the daily challenge, registered in the solver registry
+var Solver = registry.Register(registry.NewSolver(2023, 24, "Never Tell Me The Odds", Part1, Part2))
+
 var zero = big.NewRat(0, 1)
 
 type RatVec3 struct {
@@ -81,16 +84,6 @@ func (stone *HailStone) Subtract(other *HailStone) *HailStone {
 	}
 }
 
-// Run function of the daily challenge
-func Run(input []string, mode int) {
-	if mode == 1 || mode == 3 {
-		fmt.Printf("Part one: %v\n", Part1(input))
-	}
-	if mode == 2 || mode == 3 {
-		fmt.Printf("Part two: %v\n", Part2(input))
-	}
-}
-
 // Part1 solves the first part of the exercise
 func Part1(input []string) string {
 	hailStones := readInput(input)
@@ -1,13 +1,17 @@
 package day_25
 
 import (
-	"fmt"
+	"context"
+	"github.com/wlchs/advent_of_code_go_template/internal/registry"
 	"math/rand"
 	"slices"
 	"strconv"
 	"strings"
 )
 
+// Solver of the daily challenge, registered in the solver registry
+var Solver = registry.Register(registry.NewContextSolver(2023, 25, "Snowverload", Part1, nil))
+
 // Node represents a node of a graph
 type Node struct {
 	label          string
@@ -20,21 +24,17 @@ type Edge struct {
 	v *Node
 }
 
-// Run function of the daily challenge
-func Run(input []string, mode int) {
-	if mode == 1 || mode == 3 {
-		fmt.Printf("Part one: %v\n", Part1(input))
-	}
-}
-
 // Part1 solves the first part of the exercise
-func Part1(input []string) string {
+func Part1(ctx context.Context, input []string) (string, error) {
 	nodeMap := readGraph(input)
 
 	for {
+		if err := ctx.Err(); err != nil {
+			return "", err
+		}
 		cut, res := minCut(nodeMap)
 		if cut == 6 {
-			return strconv.Itoa(res)
+			return strconv.Itoa(res), nil
 		}
 	}
 }
@@ -0,0 +1,30 @@
+// Package years links the daily challenges of every year into the binary, so they can register their solvers.
+package years
+
+import (
+	_ "github.com/wlchs/advent_of_code_go_template/years/2023/day_01"
+	_ "github.com/wlchs/advent_of_code_go_template/years/2023/day_02"
+	_ "github.com/wlchs/advent_of_code_go_template/years/2023/day_03"
+	_ "github.com/wlchs/advent_of_code_go_template/years/2023/day_04"
+	_ "github.com/wlchs/advent_of_code_go_template/years/2023/day_05"
+	_ "github.com/wlchs/advent_of_code_go_template/years/2023/day_06"
+	_ "github.com/wlchs/advent_of_code_go_template/years/2023/day_07"
+	_ "github.com/wlchs/advent_of_code_go_template/years/2023/day_08"
+	_ "github.com/wlchs/advent_of_code_go_template/years/2023/day_09"
+	_ "github.com/wlchs/advent_of_code_go_template/years/2023/day_10"
+	_ "github.com/wlchs/advent_of_code_go_template/years/2023/day_11"
+	_ "github.com/wlchs/advent_of_code_go_template/years/2023/day_12"
+	_ "github.com/wlchs/advent_of_code_go_template/years/2023/day_13"
+	_ "github.com/wlchs/advent_of_code_go_template/years/2023/day_14"
+	_ "github.com/wlchs/advent_of_code_go_template/years/2023/day_15"
+	_ "github.com/wlchs/advent_of_code_go_template/years/2023/day_16"
+	_ "github.com/wlchs/advent_of_code_go_template/years/2023/day_17"
+	_ "github.com/wlchs/advent_of_code_go_template/years/2023/day_18"
+	_ "github.com/wlchs/advent_of_code_go_template/years/2023/day_19"
+	_ "github.com/wlchs/advent_of_code_go_template/years/2023/day_20"
+	_ "github.com/wlchs/advent_of_code_go_template/years/2023/day_21"
+	_ "github.com/wlchs/advent_of_code_go_template/years/2023/day_22"
+	_ "github.com/wlchs/advent_of_code_go_template/years/2023/day_23"
+	_ "github.com/wlchs/advent_of_code_go_template/years/2023/day_24"
+	_ "github.com/wlchs/advent_of_code_go_template/years/2023/day_25"
+)
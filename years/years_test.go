@@ -0,0 +1,29 @@
+package years_test
+
+import (
+	"fmt"
+	"github.com/wlchs/advent_of_code_go_template/internal/harness"
+	"github.com/wlchs/advent_of_code_go_template/internal/registry"
+	_ "github.com/wlchs/advent_of_code_go_template/years"
+	"path/filepath"
+	"testing"
+)
+
+// TestSolversConcurrently runs the examples of every solver concurrently, so that package-level state shared between
+// the runs is reported by the race detector or by diverging answers.
+func TestSolversConcurrently(t *testing.T) {
+	t.Parallel()
+
+	for _, s := range registry.All() {
+		s := s
+		dir := filepath.Join(fmt.Sprint(s.Year()), fmt.Sprintf("day_%02d", s.Day()))
+		t.Run(fmt.Sprintf("%d/day_%02d", s.Year(), s.Day()), func(t *testing.T) {
+			t.Parallel()
+			harness.Concurrent(t, s, dir, 4)
+		})
+	}
+}
+
+func BenchmarkSolvers(b *testing.B) {
+	harness.BenchmarkAll(b, ".")
+}